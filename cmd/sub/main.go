@@ -8,7 +8,6 @@ import (
 	"syscall"
 	"time"
 
-	_ "github.com/DenHax/subscription-manager/docs"
 	"github.com/DenHax/subscription-manager/internal/http/handler"
 	"github.com/DenHax/subscription-manager/internal/http/server"
 	"github.com/DenHax/subscription-manager/internal/logger/slogger"
@@ -17,11 +16,6 @@ import (
 	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
 )
 
-// @title API for Subscription aggregation
-// @version 1.0
-// @description API for Subscription aggregation for Effective Mobile
-// @host localhost:8080
-// @BasePath /
 func main() {
 	slogger.InitLogging()
 
@@ -39,7 +33,6 @@ func main() {
 
 	repos := repo.NewRepository(storage)
 	services := service.NewService(repos)
-	handlers := handler.NewHandler(services)
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
@@ -49,6 +42,9 @@ func main() {
 		slog.Error("Failed to setup config", slog.String("error", err.Error()))
 		os.Exit(3)
 	}
+
+	handlers := handler.NewHandler(services, serverConfig.QueryTimeout, serverConfig.StreamTimeout)
+
 	slog.Info("starting server", slog.String("address", serverConfig.Address))
 	srv := server.New(*serverConfig, handlers.Init())
 
@@ -0,0 +1,42 @@
+package models
+
+// Operations of a subscription batch.
+const (
+	BatchCreate = "create"
+	BatchUpdate = "update"
+	BatchDelete = "delete"
+)
+
+// MaxBatchSize caps the number of operations in one batch.
+const MaxBatchSize = 100
+
+// SubscriptionChange holds the fields a batch operation sets. Updates keep nil fields as they are
+// and read an empty EndDate as clearing it, creates require the fields a new subscription needs.
+type SubscriptionChange struct {
+	ServiceName     *string
+	Price           *int
+	Currency        *string
+	PriceFrom       *string
+	BillingPeriod   *string
+	BillingInterval *int
+	UserID          *string
+	StartDate       *string
+	EndDate         *string
+}
+
+// BatchOperation is one create, update or delete of a batch. ID and Versions only apply to
+// updates and deletes, which must either give the versions they may apply to or set AnyVersion.
+type BatchOperation struct {
+	Op         string
+	ID         string
+	Versions   []int
+	AnyVersion bool
+	Change     SubscriptionChange
+}
+
+// BatchResult is the outcome of one batch operation. Subscription is nil for deletes and
+// failed operations.
+type BatchResult struct {
+	Subscription *Subscription
+	Err          error
+}
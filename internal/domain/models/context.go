@@ -0,0 +1,22 @@
+package models
+
+import "context"
+
+type requestMetaKey struct{}
+
+// RequestMeta identifies who made a request, it is stored with every audited change.
+type RequestMeta struct {
+	Actor     string
+	RequestID string
+}
+
+// WithRequestMeta returns a copy of ctx carrying meta.
+func WithRequestMeta(ctx context.Context, meta RequestMeta) context.Context {
+	return context.WithValue(ctx, requestMetaKey{}, meta)
+}
+
+// RequestMetaFrom returns the meta stored in ctx, or the zero value when there is none.
+func RequestMetaFrom(ctx context.Context) RequestMeta {
+	meta, _ := ctx.Value(requestMetaKey{}).(RequestMeta)
+	return meta
+}
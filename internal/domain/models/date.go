@@ -0,0 +1,99 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Date layouts: ISO 8601 days, and the legacy month-year which stands for a whole month.
+const (
+	DateLayout      = "2006-01-02"
+	MonthYearLayout = "01-2006"
+)
+
+// Date is a calendar day in UTC. It is serialized as "2025-07-20" and also accepts the legacy
+// "07-2025", so whatever a response carries can be sent back in a request.
+type Date struct {
+	time.Time
+	// layout is the one MarshalJSON writes, DateLayout when empty
+	layout string
+}
+
+// In returns the date written as layout in JSON, like MonthYearLayout for clients that still
+// expect months. The layout only changes how the date is written.
+func (d Date) In(layout string) Date {
+	d.layout = layout
+	return d
+}
+
+// NewDate returns the day t falls on in UTC.
+func NewDate(t time.Time) Date {
+	t = t.UTC()
+	return Date{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+// ParseDate parses "2025-07-20", or a legacy "07-2025" standing for the first day of the month.
+func ParseDate(value string) (Date, error) {
+	if t, err := time.Parse(DateLayout, value); err == nil {
+		return Date{Time: t}, nil
+	}
+	t, err := time.Parse(MonthYearLayout, value)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or MM-YYYY", value)
+	}
+	return Date{Time: t}, nil
+}
+
+// ParseEndDate parses an inclusive end date like ParseDate, but a legacy month stands for its
+// last day.
+func ParseEndDate(value string) (Date, error) {
+	d, err := ParseDate(value)
+	if err != nil {
+		return Date{}, err
+	}
+	if _, err := time.Parse(MonthYearLayout, value); err == nil {
+		return Date{Time: d.AddDate(0, 1, -1)}, nil
+	}
+	return d, nil
+}
+
+func (d Date) String() string {
+	return d.Format(DateLayout)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.layout != "" {
+		return json.Marshal(d.Format(d.layout))
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner for timestamp columns.
+func (d *Date) Scan(src interface{}) error {
+	t, ok := src.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into Date", src)
+	}
+	*d = NewDate(t)
+	return nil
+}
+
+// Value implements driver.Valuer, the day is stored as midnight UTC.
+func (d Date) Value() (driver.Value, error) {
+	return d.Time, nil
+}
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "day", value: "2025-07-20", want: "2025-07-20"},
+		{name: "leap day", value: "2024-02-29", want: "2024-02-29"},
+		{name: "month-year is the first day", value: "07-2025", want: "2025-07-01"},
+		{name: "empty", value: "", wantErr: true},
+		{name: "month out of range", value: "13-2025", wantErr: true},
+		{name: "day out of range", value: "2025-02-30", wantErr: true},
+		{name: "not a leap year", value: "2025-02-29", wantErr: true},
+		{name: "year-month", value: "2025-07", wantErr: true},
+		{name: "with time", value: "2025-07-20T00:00:00Z", wantErr: true},
+		{name: "single digit month", value: "7-2025", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDate(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDate(%q) = %s, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error: %v", tt.value, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseDate(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("ParseDate(%q) location = %s, want UTC", tt.value, got.Location())
+			}
+		})
+	}
+}
+
+func TestParseEndDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "day is kept", value: "2025-07-20", want: "2025-07-20"},
+		{name: "first day is kept", value: "2025-07-01", want: "2025-07-01"},
+		{name: "month-year is the last day", value: "07-2025", want: "2025-07-31"},
+		{name: "thirty day month", value: "06-2025", want: "2025-06-30"},
+		{name: "february", value: "02-2025", want: "2025-02-28"},
+		{name: "february of a leap year", value: "02-2024", want: "2024-02-29"},
+		{name: "december", value: "12-2025", want: "2025-12-31"},
+		{name: "invalid", value: "2025/07/20", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEndDate(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseEndDate(%q) = %s, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseEndDate(%q) error: %v", tt.value, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseEndDate(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{name: "day", data: `"2025-07-20"`, want: `"2025-07-20"`},
+		{name: "month-year", data: `"07-2025"`, want: `"2025-07-01"`},
+		{name: "invalid", data: `"20-07-2025"`, wantErr: true},
+		{name: "not a string", data: `20250720`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			err := json.Unmarshal([]byte(tt.data), &d)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Unmarshal(%s) = %s, want error", tt.data, d)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal(%s) error: %v", tt.data, err)
+			}
+
+			got, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("Marshal(%s) error: %v", d, err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("round trip of %s = %s, want %s", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDate(t *testing.T) {
+	// 23:30 on the 19th in UTC-3 is already the 20th in UTC
+	zone := time.FixedZone("UTC-3", -3*60*60)
+	got := NewDate(time.Date(2025, 7, 19, 23, 30, 0, 0, zone))
+
+	want := time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) || got.Location() != time.UTC {
+		t.Errorf("NewDate = %v, want %v", got.Time, want)
+	}
+}
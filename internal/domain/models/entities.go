@@ -1,12 +1,150 @@
 package models
 
-import "time"
+import (
+	"regexp"
+	"time"
+)
 
 type Subscription struct {
-	Id          int       `json:"subscription_id" db:"subscription_id"`
-	UserId      string    `json:"user_id" db:"user_id"`
-	ServiceName string    `json:"service_name" db:"service_name"`
-	Price       int       `json:"price" db:"price"`
-	StartDate   time.Time `json:"start_date" db:"start_date"`
-	EndDate     time.Time `json:"end_date" db:"end_date"`
+	Id              string     `json:"subscription_id" db:"subscription_id"`
+	UserId          string     `json:"user_id" db:"user_id"`
+	ServiceName     string     `json:"service_name" db:"service_name"`
+	Price           int        `json:"price" db:"price"`
+	Currency        string     `json:"currency" db:"currency"`
+	BillingPeriod   string     `json:"billing_period" db:"billing_period"`
+	BillingInterval int        `json:"billing_interval" db:"billing_interval"`
+	StartDate       Date       `json:"start_date" db:"start_date"`
+	EndDate         *Date      `json:"end_date" db:"end_date"`
+	Version         int        `json:"version" db:"version"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	// Status is derived from the dates and not part of event snapshots
+	Status string `json:"status,omitempty" db:"status"`
+}
+
+// Subscription statuses, relative to the current day.
+const (
+	StatusUpcoming = "upcoming"
+	StatusActive   = "active"
+	StatusExpired  = "expired"
+)
+
+// IsSubscriptionStatus reports whether status is one of the subscription statuses.
+func IsSubscriptionStatus(status string) bool {
+	switch status {
+	case StatusUpcoming, StatusActive, StatusExpired:
+		return true
+	}
+	return false
+}
+
+// Billing periods, the price is charged every BillingInterval of them counted from StartDate.
+const (
+	BillingWeekly    = "weekly"
+	BillingMonthly   = "monthly"
+	BillingQuarterly = "quarterly"
+	BillingYearly    = "yearly"
+)
+
+// IsBillingPeriod reports whether period is one of the supported billing periods.
+func IsBillingPeriod(period string) bool {
+	switch period {
+	case BillingWeekly, BillingMonthly, BillingQuarterly, BillingYearly:
+		return true
+	}
+	return false
+}
+
+// PricePeriod is the price of a subscription from EffectiveFrom until the next period starts.
+type PricePeriod struct {
+	EffectiveFrom Date   `json:"effective_from" db:"effective_from"`
+	Price         int    `json:"price" db:"price"`
+	Currency      string `json:"currency" db:"currency"`
+}
+
+// BaseCurrency is the currency prices default to and exchange rates are quoted in.
+const BaseCurrency = "RUB"
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// IsCurrencyCode reports whether code looks like an ISO 4217 currency code.
+func IsCurrencyCode(code string) bool {
+	return currencyCode.MatchString(code)
+}
+
+// ExchangeRate is the value of one unit of Currency in BaseCurrency from EffectiveFrom until
+// the next rate for that currency takes over.
+type ExchangeRate struct {
+	Currency      string  `json:"currency" db:"currency"`
+	EffectiveFrom Date    `json:"effective_from" db:"effective_from"`
+	Rate          float64 `json:"rate" db:"rate"`
+}
+
+type User struct {
+	Id       string `json:"user_id" db:"user_id"`
+	Username string `json:"username" db:"username"`
+}
+
+type Service struct {
+	Name         string  `json:"service_name" db:"service_name"`
+	DisplayName  *string `json:"display_name" db:"display_name"`
+	Category     *string `json:"category" db:"category"`
+	DefaultPrice *int    `json:"default_price" db:"default_price"`
+	Currency     string  `json:"currency" db:"currency"`
+	VendorURL    *string `json:"vendor_url" db:"vendor_url"`
+}
+
+type Summary struct {
+	TotalCost          int    `json:"total_cost"`
+	Currency           string `json:"currency"`
+	Months             int    `json:"months"`
+	SubscriptionMonths int    `json:"subscription_months"`
+	Charges            int    `json:"charges"`
+}
+
+type MonthlyCost struct {
+	Month         string `json:"month"`
+	Cost          int    `json:"cost"`
+	Subscriptions int    `json:"subscriptions"`
+}
+
+// Ways a summary attributes cost to its window: whole charges on their billing dates, or each
+// billing period's price spread evenly over its days.
+const (
+	ProrationNone  = "none"
+	ProrationDaily = "daily"
+)
+
+const (
+	GroupByMonth       = "month"
+	GroupByServiceName = "service_name"
+	GroupByUserID      = "user_id"
+)
+
+type GroupCost struct {
+	Key           string  `json:"key"`
+	Cost          int     `json:"cost"`
+	Subscriptions int     `json:"subscriptions"`
+	Share         float64 `json:"share"`
+}
+
+// Actions recorded in the subscription audit log.
+const (
+	EventCreated  = "created"
+	EventUpdated  = "updated"
+	EventDeleted  = "deleted"
+	EventRestored = "restored"
+	EventPurged   = "purged"
+)
+
+// SubscriptionEvent is one audit log entry with the subscription as it was before and after
+// the change. Before is null for creations and after for purges.
+type SubscriptionEvent struct {
+	Id             int64         `json:"event_id" db:"event_id"`
+	SubscriptionId string        `json:"subscription_id" db:"subscription_id"`
+	Action         string        `json:"action" db:"action"`
+	Actor          string        `json:"actor" db:"actor"`
+	RequestId      *string       `json:"request_id" db:"request_id"`
+	Before         *Subscription `json:"before" db:"before"`
+	After          *Subscription `json:"after" db:"after"`
+	CreatedAt      time.Time     `json:"created_at" db:"created_at"`
 }
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrValidation   = errors.New("validation failed")
+	ErrConflict     = errors.New("conflict")
+	ErrReference    = errors.New("unknown reference")
+	ErrPrecondition = errors.New("precondition failed")
+	ErrAborted      = errors.New("aborted")
+
+	ErrPreconditionRequired = errors.New("precondition required")
+)
+
+// NotFoundError reports a missing entity, e.g. a subscription looked up by ID.
+type NotFoundError struct {
+	Entity string
+	ID     string
+}
+
+func (e *NotFoundError) Error() string {
+	if e.ID == "" {
+		return fmt.Sprintf("%s not found", e.Entity)
+	}
+	return fmt.Sprintf("%s %s not found", e.Entity, e.ID)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return ErrNotFound
+}
+
+// ValidationError reports input that was rejected before or by the storage.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// ConflictError reports a request that clashes with existing data, e.g. a duplicate name.
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// ReferenceError reports a foreign key pointing to a user or service that does not exist.
+type ReferenceError struct {
+	Entity string
+	Field  string
+	Value  string
+}
+
+func (e *ReferenceError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("referenced %s does not exist", e.Entity)
+	}
+	return fmt.Sprintf("%s %q does not exist", e.Entity, e.Value)
+}
+
+func (e *ReferenceError) Unwrap() error {
+	return ErrReference
+}
+
+// PreconditionError reports a write made against a version that is no longer current.
+type PreconditionError struct {
+	Entity  string
+	ID      string
+	Version int
+}
+
+func (e *PreconditionError) Error() string {
+	return fmt.Sprintf("%s %s was modified, current version is %d", e.Entity, e.ID, e.Version)
+}
+
+func (e *PreconditionError) Unwrap() error {
+	return ErrPrecondition
+}
+
+// PreconditionRequiredError reports a write that did not say which version it was made against.
+type PreconditionRequiredError struct {
+	Entity string
+	ID     string
+	Field  string
+}
+
+func (e *PreconditionRequiredError) Error() string {
+	return fmt.Sprintf("%s is required to change %s %s, send its version or \"*\"", e.Field, e.Entity, e.ID)
+}
+
+func (e *PreconditionRequiredError) Unwrap() error {
+	return ErrPreconditionRequired
+}
+
+// AbortedError reports an operation that was rolled back or never run because another operation
+// in the same transaction failed.
+type AbortedError struct {
+	Index int
+}
+
+func (e *AbortedError) Error() string {
+	return fmt.Sprintf("not applied, operation %d failed", e.Index)
+}
+
+func (e *AbortedError) Unwrap() error {
+	return ErrAborted
+}
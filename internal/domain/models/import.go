@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// Subscription fields a CSV import reads. Unless mapped otherwise, each is read from the column
+// whose header is the field name.
+var ImportFields = []string{
+	"service_name",
+	"price",
+	"currency",
+	"billing_period",
+	"billing_interval",
+	"user_id",
+	"start_date",
+	"end_date",
+}
+
+// ImportOptions configure how a CSV import is read.
+type ImportOptions struct {
+	// Columns maps fields onto the headers they are read from
+	Columns   map[string]string
+	Delimiter rune
+	// DryRun validates every row against the database and rolls it back
+	DryRun bool
+	// RowTimeout bounds the queries of each row, zero leaves them unbounded
+	RowTimeout time.Duration
+}
+
+// ImportRow is the outcome of one data row of an import. Subscription is the one created for
+// the row, Err why the row was rejected.
+type ImportRow struct {
+	Line         int
+	Subscription *Subscription
+	Err          error
+}
+
+// ImportReport counts the accepted and rejected data rows of an import.
+type ImportReport struct {
+	Accepted int
+	Rejected int
+}
@@ -0,0 +1,46 @@
+package models
+
+// Keys subscriptions can be sorted by, prefixed with "-" for descending order. A sort lists one
+// or more keys separated by commas, like "price,-start_date". Ties are broken by subscription ID
+// so the order is stable.
+const (
+	SortStartDate   = "start_date"
+	SortPrice       = "price"
+	SortServiceName = "service_name"
+)
+
+// SubscriptionFilter narrows a subscription list. Every set field must match, dates are kept as
+// sent and parsed by the repository like any other request date.
+type SubscriptionFilter struct {
+	UserIDs           []string
+	ServiceName       *string
+	ServiceNamePrefix *string
+	MinPrice          *int
+	MaxPrice          *int
+	Status            *string
+	// ActiveAt keeps subscriptions running on that day, or at any time in a legacy month
+	ActiveAt      *string
+	StartDateFrom *string
+	StartDateTo   *string
+	EndDateFrom   *string
+	EndDateTo     *string
+
+	IncludeDeleted bool
+}
+
+// PageRequest selects a page of a list either by Offset or by the Cursor a previous page
+// returned, never both.
+type PageRequest struct {
+	Limit     int
+	Offset    int
+	Cursor    string
+	Sort      string
+	SkipTotal bool
+}
+
+// PageInfo describes a returned page. Total is nil when counting was skipped and NextCursor is
+// empty on the last page.
+type PageInfo struct {
+	Total      *int
+	NextCursor string
+}
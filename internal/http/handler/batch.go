@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/http/server"
+	"github.com/gin-gonic/gin"
+)
+
+// successStatus is the status each operation reports when it succeeds, matching the single
+// subscription endpoints.
+var successStatus = map[string]int{
+	models.BatchCreate: http.StatusCreated,
+	models.BatchUpdate: http.StatusOK,
+	models.BatchDelete: http.StatusNoContent,
+}
+
+// BatchSubscriptions applies several creates, updates and deletes and reports a result for each.
+// Failed operations do not fail the request, they carry the error they would have returned on
+// their own.
+func (h *Handler) BatchSubscriptions(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
+
+	var req server.BatchSubscriptionsJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
+
+	// Updates are merge patches, the raw fields tell a null end_date from an absent one
+	var raw struct {
+		Operations []struct {
+			Subscription map[string]json.RawMessage `json:"subscription"`
+		} `json:"operations"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
+
+	ops := make([]models.BatchOperation, len(req.Operations))
+	for i, op := range req.Operations {
+		ops[i].Op = string(op.Op)
+		if op.SubscriptionId != nil {
+			ops[i].ID = op.SubscriptionId.String()
+		}
+		if op.IfMatch != nil {
+			if version, err := op.IfMatch.AsIfMatchVersion(); err == nil {
+				ops[i].Versions = []int{version}
+			} else {
+				ops[i].AnyVersion = true
+			}
+		}
+
+		if sub := op.Subscription; sub != nil {
+			ops[i].Change = models.SubscriptionChange{
+				ServiceName:     sub.ServiceName,
+				Price:           sub.Price,
+				Currency:        sub.Currency,
+				PriceFrom:       sub.PriceEffectiveFrom,
+				BillingPeriod:   (*string)(sub.BillingPeriod),
+				BillingInterval: sub.BillingInterval,
+				UserID:          uuidString(sub.UserId),
+				StartDate:       sub.StartDate,
+				EndDate:         patchedEndDate(sub.EndDate, raw.Operations[i].Subscription),
+			}
+		}
+	}
+
+	atomic := req.Mode == nil || *req.Mode == server.Atomic
+
+	results, err := h.Services.BatchSubscriptions(c.Request.Context(), ops, atomic)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	items := make([]gin.H, len(results))
+	succeeded := 0
+	for i, result := range results {
+		item := gin.H{"index": i}
+		if result.Err != nil {
+			status, detail := errorDetail(c, result.Err)
+			item["status"] = status
+			item["error"] = detail
+		} else {
+			succeeded++
+			item["status"] = successStatus[ops[i].Op]
+			if result.Subscription != nil {
+				item["subscription"] = result.Subscription
+			}
+		}
+		items[i] = item
+	}
+
+	writeJSON(c, http.StatusOK, gin.H{
+		"results":   items,
+		"succeeded": succeeded,
+		"failed":    len(results) - succeeded,
+	})
+}
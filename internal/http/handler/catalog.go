@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/DenHax/subscription-manager/internal/http/server"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListServices(c *gin.Context, params server.ListServicesParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	services, total, err := h.Services.GetAllServices(c.Request.Context(), limit, offset)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, gin.H{
+		"services": services,
+		"total":    total,
+	})
+}
+
+func (h *Handler) CreateService(c *gin.Context) {
+	var req server.CreateServiceJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
+
+	currency := ""
+	if req.Currency != nil {
+		currency = *req.Currency
+	}
+
+	svc, err := h.Services.CreateService(c.Request.Context(), req.ServiceName, req.DisplayName, req.Category, req.DefaultPrice, currency, req.VendorUrl)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusCreated, svc)
+}
+
+func (h *Handler) GetService(c *gin.Context, name server.ServiceName) {
+	svc, err := h.Services.Service(c.Request.Context(), name)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, svc)
+}
+
+func (h *Handler) UpdateService(c *gin.Context, name server.ServiceName) {
+	var req server.UpdateServiceJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
+
+	svc, err := h.Services.UpdateService(c.Request.Context(), name, req.ServiceName, req.DisplayName, req.Category, req.DefaultPrice, req.Currency, req.VendorUrl)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, svc)
+}
+
+func (h *Handler) DeleteService(c *gin.Context, name server.ServiceName) {
+	if err := h.Services.DeleteService(c.Request.Context(), name); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -1,5 +1,33 @@
 package handler
 
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/gin-gonic/gin"
+)
+
+// Machine-readable error codes returned in ErrorDetail.Code.
+const (
+	CodeBadRequest           = "bad_request"
+	CodeValidation           = "validation_error"
+	CodeNotFound             = "not_found"
+	CodeConflict             = "conflict"
+	CodeUnknownReference     = "unknown_reference"
+	CodePrecondition         = "precondition_failed"
+	CodePreconditionRequired = "precondition_required"
+	CodeAborted              = "aborted"
+	CodeTimeout              = "timeout"
+	CodeCanceled             = "canceled"
+	CodeInternal             = "internal_error"
+)
+
+// statusClientClosedRequest is the non-standard status used when the client disconnects first.
+const statusClientClosedRequest = 499
+
 type ErrorResponse struct {
 	Error ErrorDetail `json:"error"`
 }
@@ -7,4 +35,79 @@ type ErrorResponse struct {
 type ErrorDetail struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+const defaultLimit = 10
+
+// pagination applies the defaults for optional limit and offset query parameters.
+func pagination(limit, offset *int) (int, int) {
+	l, o := defaultLimit, 0
+	if limit != nil {
+		l = *limit
+	}
+	if offset != nil {
+		o = *offset
+	}
+	return l, o
+}
+
+// writeError maps a domain error onto its HTTP status and writes it as an ErrorResponse.
+func writeError(c *gin.Context, err error) {
+	status, detail := errorDetail(c, err)
+	abortWithError(c, status, detail)
+}
+
+// errorDetail maps a domain error onto its HTTP status and the detail reported to the client.
+func errorDetail(c *gin.Context, err error) (int, ErrorDetail) {
+	var (
+		notFound     *models.NotFoundError
+		validation   *models.ValidationError
+		reference    *models.ReferenceError
+		conflict     *models.ConflictError
+		precondition *models.PreconditionError
+		required     *models.PreconditionRequiredError
+		aborted      *models.AbortedError
+	)
+
+	// Drivers report cancellation in their own words, so trust the request context instead.
+	ctxErr := c.Request.Context().Err()
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(ctxErr, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, ErrorDetail{Code: CodeTimeout, Message: "request timed out"}
+	case errors.Is(err, context.Canceled) || errors.Is(ctxErr, context.Canceled):
+		return statusClientClosedRequest, ErrorDetail{Code: CodeCanceled, Message: "request canceled"}
+	case errors.As(err, &notFound):
+		return http.StatusNotFound, ErrorDetail{Code: CodeNotFound, Message: notFound.Error()}
+	case errors.As(err, &validation):
+		return http.StatusBadRequest, ErrorDetail{Code: CodeValidation, Message: validation.Error(), Field: validation.Field}
+	case errors.As(err, &reference):
+		return http.StatusUnprocessableEntity, ErrorDetail{Code: CodeUnknownReference, Message: reference.Error(), Field: reference.Field}
+	case errors.As(err, &precondition):
+		return http.StatusPreconditionFailed, ErrorDetail{Code: CodePrecondition, Message: precondition.Error()}
+	case errors.As(err, &required):
+		return http.StatusPreconditionRequired, ErrorDetail{Code: CodePreconditionRequired, Message: required.Error(), Field: required.Field}
+	case errors.As(err, &aborted):
+		return http.StatusFailedDependency, ErrorDetail{Code: CodeAborted, Message: aborted.Error()}
+	case errors.As(err, &conflict):
+		return http.StatusConflict, ErrorDetail{Code: CodeConflict, Message: conflict.Error()}
+	case errors.Is(err, models.ErrConflict):
+		return http.StatusConflict, ErrorDetail{Code: CodeConflict, Message: "resource conflicts with existing data"}
+	default:
+		slog.Error("Request failed",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.FullPath()),
+			slog.Any("error", err))
+		return http.StatusInternalServerError, ErrorDetail{Code: CodeInternal, Message: "internal server error"}
+	}
+}
+
+// badRequest rejects malformed input that never reached the service layer.
+func badRequest(c *gin.Context, field, message string) {
+	abortWithError(c, http.StatusBadRequest, ErrorDetail{Code: CodeBadRequest, Message: message, Field: field})
+}
+
+func abortWithError(c *gin.Context, status int, detail ErrorDetail) {
+	c.AbortWithStatusJSON(status, ErrorResponse{Error: detail})
 }
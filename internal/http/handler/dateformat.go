@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	headerDateFormat = "X-Date-Format"
+
+	// dateFormatMonthYear asks for dates as "07-2025", the format responses had before they
+	// carried days
+	dateFormatMonthYear = "month-year"
+
+	dateLayoutKey = "date_layout"
+)
+
+// withDateFormat keeps month-year responses available to legacy clients: with X-Date-Format
+// set to month-year, writeJSON writes every date of the response as the month it falls in.
+// Requests accept either format regardless of the header.
+func (h *Handler) withDateFormat(c *gin.Context) {
+	c.Writer.Header().Add("Vary", headerDateFormat)
+
+	if strings.EqualFold(c.GetHeader(headerDateFormat), dateFormatMonthYear) {
+		c.Set(dateLayoutKey, models.MonthYearLayout)
+	}
+	c.Next()
+}
+
+// writeJSON renders v as the JSON response, its dates in the layout the request asked for.
+func writeJSON(c *gin.Context, status int, v interface{}) {
+	if layout := c.GetString(dateLayoutKey); layout != "" && v != nil {
+		value := reflect.New(reflect.TypeOf(v)).Elem()
+		value.Set(reflect.ValueOf(v))
+		setDateLayout(value, layout)
+		v = value.Interface()
+	}
+	c.JSON(status, v)
+}
+
+var dateType = reflect.TypeOf(models.Date{})
+
+// setDateLayout sets layout on every date reachable from v, which must be settable. Values held
+// by maps and interfaces are copied, updated and stored back, as they cannot be set in place.
+func setDateLayout(v reflect.Value, layout string) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			setDateLayout(v.Elem(), layout)
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		elem := reflect.New(v.Elem().Type()).Elem()
+		elem.Set(v.Elem())
+		setDateLayout(elem, layout)
+		v.Set(elem)
+	case reflect.Struct:
+		if v.Type() == dateType {
+			v.Set(reflect.ValueOf(v.Interface().(models.Date).In(layout)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			// Unexported fields are not written, so their dates never show
+			if v.Type().Field(i).IsExported() {
+				setDateLayout(v.Field(i), layout)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			setDateLayout(v.Index(i), layout)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			setDateLayout(elem, layout)
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/gin-gonic/gin"
+)
+
+func TestWriteJSONDateFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	date := func(value string) models.Date {
+		d, err := models.ParseDate(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	end := date("2025-09-30")
+	sub := func() *models.Subscription {
+		return &models.Subscription{Id: "s", StartDate: date("2025-07-20"), EndDate: &end}
+	}
+
+	tests := []struct {
+		name   string
+		header string
+		body   func() interface{}
+		want   string
+	}{
+		{
+			name: "days by default",
+			body: func() interface{} { return sub() },
+			want: `"start_date":"2025-07-20","end_date":"2025-09-30"`,
+		},
+		{
+			name:   "subscription",
+			header: "month-year",
+			body:   func() interface{} { return sub() },
+			want:   `"start_date":"07-2025","end_date":"09-2025"`,
+		},
+		{
+			name:   "header is case-insensitive",
+			header: "Month-Year",
+			body:   func() interface{} { return sub() },
+			want:   `"start_date":"07-2025","end_date":"09-2025"`,
+		},
+		{
+			name:   "list in a map",
+			header: "month-year",
+			body: func() interface{} {
+				return gin.H{"subscriptions": []*models.Subscription{sub()}}
+			},
+			want: `{"subscriptions":[{"subscription_id":"s","user_id":"","service_name":"","price":0,"currency":"","billing_period":"","billing_interval":0,"start_date":"07-2025","end_date":"09-2025","version":0}]}`,
+		},
+		{
+			name:   "value held by a map",
+			header: "month-year",
+			body: func() interface{} {
+				return gin.H{"rate": models.ExchangeRate{Currency: "USD", EffectiveFrom: date("2025-07-01"), Rate: 90}}
+			},
+			want: `{"rate":{"currency":"USD","effective_from":"07-2025","rate":90}}`,
+		},
+		{
+			name:   "event snapshots",
+			header: "month-year",
+			body: func() interface{} {
+				return []*models.SubscriptionEvent{{Action: models.EventCreated, After: sub()}}
+			},
+			want: `"after":{"subscription_id":"s","user_id":"","service_name":"","price":0,"currency":"","billing_period":"","billing_interval":0,"start_date":"07-2025","end_date":"09-2025","version":0}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				c.Request.Header.Set(headerDateFormat, tt.header)
+			}
+
+			(&Handler{}).withDateFormat(c)
+			writeJSON(c, http.StatusOK, tt.body())
+
+			if got := w.Body.String(); !strings.Contains(got, tt.want) {
+				t.Errorf("body = %s, want it to contain %s", got, tt.want)
+			}
+			if vary := w.Header().Get("Vary"); vary != headerDateFormat {
+				t.Errorf("Vary = %q, want %q", vary, headerDateFormat)
+			}
+		})
+	}
+}
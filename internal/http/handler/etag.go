@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// etag renders a subscription version as a strong entity tag.
+func etag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+func setETag(c *gin.Context, version int) {
+	c.Header("ETag", etag(version))
+}
+
+// requireIfMatch returns the versions a write may apply to, answering 428 when the If-Match
+// header is missing so clients cannot overwrite a subscription without saying which version
+// they saw. "*" remains the explicit way to accept any version.
+func requireIfMatch(c *gin.Context, header *string) ([]int, bool) {
+	if header == nil {
+		abortWithError(c, http.StatusPreconditionRequired, ErrorDetail{
+			Code:    CodePreconditionRequired,
+			Message: `If-Match header is required, send the subscription ETag or "*"`,
+			Field:   "If-Match",
+		})
+		return nil, false
+	}
+	return ifMatchVersions(header), true
+}
+
+// ifMatchVersions turns an If-Match header into the versions a write may apply to. Nil means
+// the header was absent or "*", so any version is accepted. Weak and foreign tags never match
+// under the strong comparison If-Match requires, leaving an empty, unsatisfiable slice.
+func ifMatchVersions(header *string) []int {
+	if header == nil {
+		return nil
+	}
+
+	versions := []int{}
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return nil
+		}
+		if version, ok := parseETag(tag); ok {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// noneMatch reports whether an If-None-Match header matches the version, using the weak
+// comparison the header calls for.
+func noneMatch(header *string, version int) bool {
+	if header == nil {
+		return false
+	}
+
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if v, ok := parseETag(strings.TrimPrefix(tag, "W/")); ok && v == version {
+			return true
+		}
+	}
+	return false
+}
+
+func parseETag(tag string) (int, bool) {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil {
+		return 0, false
+	}
+	return version, true
+}
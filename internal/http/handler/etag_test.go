@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestIfMatchVersions(t *testing.T) {
+	tests := []struct {
+		name   string
+		header *string
+		want   []int
+	}{
+		{name: "absent", header: nil, want: nil},
+		{name: "any", header: ptr("*"), want: nil},
+		{name: "any among tags", header: ptr(`"1", *`), want: nil},
+		{name: "single", header: ptr(`"3"`), want: []int{3}},
+		{name: "list", header: ptr(`"3", "4","5"`), want: []int{3, 4, 5}},
+		{name: "weak never matches", header: ptr(`W/"3"`), want: []int{}},
+		{name: "weak is skipped", header: ptr(`W/"3", "4"`), want: []int{4}},
+		{name: "unquoted", header: ptr(`3`), want: []int{}},
+		{name: "foreign tag", header: ptr(`"abc"`), want: []int{}},
+		{name: "empty", header: ptr(""), want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ifMatchVersions(tt.header)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ifMatchVersions = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNoneMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  *string
+		version int
+		want    bool
+	}{
+		{name: "absent", header: nil, version: 3, want: false},
+		{name: "any", header: ptr("*"), version: 3, want: true},
+		{name: "same", header: ptr(`"3"`), version: 3, want: true},
+		{name: "different", header: ptr(`"2"`), version: 3, want: false},
+		{name: "weak matches", header: ptr(`W/"3"`), version: 3, want: true},
+		{name: "in list", header: ptr(`"1", W/"2", "3"`), version: 3, want: true},
+		{name: "not in list", header: ptr(`"1", "2"`), version: 3, want: false},
+		{name: "foreign tag", header: ptr(`"abc"`), version: 3, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := noneMatch(tt.header, tt.version); got != tt.want {
+				t.Errorf("noneMatch(%v) = %v, want %v", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequireIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		header     *string
+		wantOK     bool
+		wantStatus int
+		want       []int
+	}{
+		{name: "absent", header: nil, wantOK: false, wantStatus: http.StatusPreconditionRequired},
+		{name: "any", header: ptr("*"), wantOK: true, wantStatus: http.StatusOK, want: nil},
+		{name: "tag", header: ptr(`"3"`), wantOK: true, wantStatus: http.StatusOK, want: []int{3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			got, ok := requireIfMatch(c, tt.header)
+			if ok != tt.wantOK {
+				t.Fatalf("requireIfMatch ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requireIfMatch = %#v, want %#v", got, tt.want)
+			}
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
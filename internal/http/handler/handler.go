@@ -1,48 +1,188 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/http/server"
 	"github.com/DenHax/subscription-manager/internal/service"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	middleware "github.com/oapi-codegen/gin-middleware"
 
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
+var _ server.ServerInterface = (*Handler)(nil)
+
 type Handler struct {
-	Services *service.Service
+	Services      *service.Service
+	queryTimeout  time.Duration
+	streamTimeout time.Duration
 }
 
-func NewHandler(services *service.Service) *Handler {
+func NewHandler(services *service.Service, queryTimeout, streamTimeout time.Duration) *Handler {
 	return &Handler{
-		Services: services,
+		Services:      services,
+		queryTimeout:  queryTimeout,
+		streamTimeout: streamTimeout,
 	}
 }
 
+// Init builds the router. API routes are generated from api/v1/openapi.yaml and every request
+// is validated against the embedded spec before it reaches the handler.
 func (h *Handler) Init() *gin.Engine {
 	router := gin.New()
 
+	spec, err := server.GetSwagger()
+	if err != nil {
+		panic("embedded openapi spec is invalid: " + err.Error())
+	}
+
+	router.GET("/openapi.json", h.serveSpec(spec))
 	router.GET("/swagger", h.redirectToSwagger)
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-
-	router.GET("/health", h.CheckHealth)
-
-	apiV1 := router.Group("/api/v1")
-	{
-		subscriptions := apiV1.Group("/subscriptions")
-		{
-			subscriptions.GET("/", h.ListSubscriptions)
-			subscriptions.POST("/", h.CreateSubscription)
-			subscriptions.GET("/:id", h.GetSubscriptionByID)
-			subscriptions.PUT("/:id", h.UpdateSubscription)
-			subscriptions.DELETE("/:id", h.DeleteSubscription)
-		}
-		apiV1.GET("/subscriptions/summary", h.GetSubscriptionSummary)
+	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, ginSwagger.URL("/openapi.json")))
+
+	api := router.Group("", h.withRequestMeta, h.withDateFormat, h.withQueryTimeout, requestValidator(spec))
+	server.RegisterHandlersWithOptions(api, h, server.GinServerOptions{
+		ErrorHandler: func(c *gin.Context, err error, _ int) {
+			badRequest(c, "", err.Error())
+		},
+	})
+
+	// Baseline clients list and create subscriptions with a trailing slash, which the router
+	// would redirect, and a redirected create loses its body on clients that do not resend it
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		router.Handle(method, "/api/v1/subscriptions/", forwardTo(router, "/api/v1/subscriptions"))
 	}
+
 	return router
 }
 
+// forwardTo serves a request as if it was made to path, through the same middleware.
+func forwardTo(router *gin.Engine, path string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.URL.Path = path
+		c.Request.URL.RawPath = ""
+		router.HandleContext(c)
+	}
+}
+
+// requestValidator checks paths, parameters and bodies against the spec with kin-openapi.
+func requestValidator(spec *openapi3.T) gin.HandlerFunc {
+	openapi3.DefineStringFormatValidator("uuid", openapi3.NewRegexpFormatValidator(openapi3.FormatOfStringForUUIDOfRFC4122))
+
+	// Servers only describe where the API is hosted, matching on them would reject other hosts
+	validated := *spec
+	validated.Servers = nil
+
+	errorHandler := func(c *gin.Context, message string, status int) {
+		if status == http.StatusNotFound {
+			abortWithError(c, status, ErrorDetail{Code: CodeNotFound, Message: message})
+			return
+		}
+		badRequest(c, "", strings.TrimPrefix(message, "error in openapi3filter.RequestError: "))
+	}
+
+	validate := middleware.OapiRequestValidatorWithOptions(&validated, &middleware.Options{
+		ErrorHandler: errorHandler,
+	})
+
+	// Validating a body means reading all of it, so streamed bodies are left to their handler
+	skipBody := middleware.OapiRequestValidatorWithOptions(&validated, &middleware.Options{
+		ErrorHandler: errorHandler,
+		Options:      openapi3filter.Options{ExcludeRequestBody: true},
+	})
+
+	return func(c *gin.Context) {
+		if streamedBodies[c.FullPath()] {
+			skipBody(c)
+			return
+		}
+		validate(c)
+	}
+}
+
+// streamedBodies lists the routes whose request body is read as a stream.
+var streamedBodies = map[string]bool{
+	"/api/v1/subscriptions/import": true,
+}
+
+const (
+	headerActor     = "X-Actor"
+	headerRequestID = "X-Request-ID"
+
+	anonymousActor = "anonymous"
+)
+
+// withRequestMeta puts the actor and request ID into the request context for the audit log.
+// A missing request ID is generated and echoed back either way.
+func (h *Handler) withRequestMeta(c *gin.Context) {
+	requestID := c.GetHeader(headerRequestID)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	c.Header(headerRequestID, requestID)
+
+	actor := c.GetHeader(headerActor)
+	if actor == "" {
+		actor = anonymousActor
+	}
+
+	ctx := models.WithRequestMeta(c.Request.Context(), models.RequestMeta{Actor: actor, RequestID: requestID})
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+}
+
+// withQueryTimeout bounds the request context, so storage calls made on behalf of the request
+// are cancelled once the configured query timeout elapses or the client goes away. Routes that
+// stream their body get the stream timeout instead, on the connection too, and bound each of
+// their queries with the query timeout themselves.
+func (h *Handler) withQueryTimeout(c *gin.Context) {
+	timeout := h.queryTimeout
+	if streamedBodies[c.FullPath()] {
+		timeout = h.streamTimeout
+		extendDeadlines(c, timeout)
+	}
+
+	if timeout <= 0 {
+		c.Next()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	defer cancel()
+
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+}
+
+// extendDeadlines moves the server's read and write deadlines for the connection, which would
+// otherwise cut off a body still being uploaded. Zero removes them.
+func extendDeadlines(c *gin.Context, timeout time.Duration) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	// Writers that cannot move deadlines, like test recorders, have none to move
+	rc := http.NewResponseController(c.Writer)
+	_ = rc.SetReadDeadline(deadline)
+	_ = rc.SetWriteDeadline(deadline)
+}
+
+func (h *Handler) serveSpec(spec *openapi3.T) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec)
+	}
+}
+
 func (h *Handler) redirectToSwagger(c *gin.Context) {
 	c.Redirect(http.StatusMovedPermanently, "/swagger/index.html")
 }
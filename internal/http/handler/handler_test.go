@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// routeSubscriptions answers list and create calls, recording that they reached the service.
+type routeSubscriptions struct {
+	service.Subscriptions
+	calls []string
+}
+
+func (s *routeSubscriptions) GetAllSubscriptions(ctx context.Context, filter models.SubscriptionFilter, page models.PageRequest) ([]*models.Subscription, models.PageInfo, error) {
+	s.calls = append(s.calls, "list")
+	return []*models.Subscription{}, models.PageInfo{}, nil
+}
+
+func (s *routeSubscriptions) CreateSubscrition(ctx context.Context, serviceName string, price int, currency, billingPeriod string, billingInterval int, userID string, startDate string, endDate *string) (*models.Subscription, error) {
+	s.calls = append(s.calls, "create "+serviceName)
+	start, _ := models.ParseDate(startDate)
+	return &models.Subscription{Id: "60601fee-2bf1-4721-ae6f-7636e79a0cba", ServiceName: serviceName, Price: price, UserId: userID, StartDate: start, Version: 1}, nil
+}
+
+func TestTrailingSlashAliases(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantCall   string
+	}{
+		{method: http.MethodGet, path: "/api/v1/subscriptions/", wantStatus: http.StatusOK, wantCall: "list"},
+		{method: http.MethodGet, path: "/api/v1/subscriptions", wantStatus: http.StatusOK, wantCall: "list"},
+		{
+			method:     http.MethodPost,
+			path:       "/api/v1/subscriptions/",
+			body:       `{"service_name":"Yandex Plus","price":400,"user_id":"60601fee-2bf1-4721-ae6f-7636e79a0cba","start_date":"07-2025"}`,
+			wantStatus: http.StatusCreated,
+			wantCall:   "create Yandex Plus",
+		},
+		{
+			// The alias is validated like the route it stands for
+			method:     http.MethodPost,
+			path:       "/api/v1/subscriptions/",
+			body:       `{"service_name":"Yandex Plus"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			subs := &routeSubscriptions{}
+			router := NewHandler(&service.Service{Subscriptions: subs}, 0, 0).Init()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.body != "" {
+				r.Header.Set("Content-Type", "application/json")
+			}
+			router.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			var got string
+			if len(subs.calls) > 0 {
+				got = subs.calls[0]
+			}
+			if len(subs.calls) > 1 || got != tt.wantCall {
+				t.Errorf("service calls = %v, want %q", subs.calls, tt.wantCall)
+			}
+		})
+	}
+}
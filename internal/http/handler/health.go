@@ -5,23 +5,16 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/DenHax/subscription-manager/internal/http/server"
 	"github.com/gin-gonic/gin"
 )
 
-// HealthCheck godoc
-// @Summary Health check
-// @Description Check application health status
-// @Tags health
-// @Accept json
-// @Produce json
-// @Success 200 {object} map[string]interface{}
-// @Router /health [get]
 func (h *Handler) CheckHealth(c *gin.Context) {
 	op := "http.handler.health"
 	slog.Debug("Health check requested", "operation", op)
 
-	c.JSON(http.StatusOK, gin.H{
-		"status":    "ok",
-		"timestamp": time.Now().UTC(),
+	c.JSON(http.StatusOK, server.Health{
+		Status:    "ok",
+		Timestamp: time.Now().UTC(),
 	})
 }
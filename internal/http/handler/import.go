@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/http/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ImportSubscriptions creates subscriptions from a CSV body, which the service reads as a stream
+// straight from the request. The report is streamed back as NDJSON, a line per row as soon as
+// it is done and the counts last, so neither side holds the whole file.
+func (h *Handler) ImportSubscriptions(c *gin.Context, params server.ImportSubscriptionsParams) {
+	opts := models.ImportOptions{
+		DryRun:     params.DryRun != nil && *params.DryRun,
+		RowTimeout: h.queryTimeout,
+	}
+	if params.Delimiter != nil {
+		if utf8.RuneCountInString(*params.Delimiter) != 1 {
+			badRequest(c, "delimiter", "delimiter must be a single character")
+			return
+		}
+		opts.Delimiter, _ = utf8.DecodeRuneInString(*params.Delimiter)
+	}
+	if params.Column != nil {
+		opts.Columns = make(map[string]string, len(*params.Column))
+		for _, column := range *params.Column {
+			field, header, ok := strings.Cut(column, ":")
+			if !ok {
+				badRequest(c, "column", "column mapping must look like field:header")
+				return
+			}
+			opts.Columns[field] = header
+		}
+	}
+
+	// The status is only committed with the first line, errors found before, like a bad header,
+	// are answered as usual
+	started := false
+	writeLine := func(line gin.H) error {
+		if !started {
+			c.Header("Content-Type", contentTypeNDJSON)
+			c.Status(http.StatusOK)
+			started = true
+		}
+		if err := json.NewEncoder(c.Writer).Encode(line); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+
+	report, err := h.Services.ImportSubscriptions(c.Request.Context(), c.Request.Body, opts, func(row models.ImportRow) error {
+		line := gin.H{"line": row.Line}
+		if row.Err != nil {
+			status, detail := errorDetail(c, row.Err)
+			line["status"] = status
+			line["error"] = detail
+		} else {
+			line["status"] = http.StatusCreated
+			// A dry run rolls the subscription back, its ID never existed
+			if !opts.DryRun {
+				line["subscription_id"] = row.Subscription.Id
+			}
+		}
+		return writeLine(line)
+	})
+	if err != nil {
+		if !started {
+			writeError(c, err)
+			return
+		}
+		// Too late for a status, the report ends with the error instead of the counts
+		_, detail := errorDetail(c, err)
+		_ = writeLine(gin.H{"error": detail})
+		return
+	}
+
+	_ = writeLine(gin.H{
+		"dry_run":  opts.DryRun,
+		"accepted": report.Accepted,
+		"rejected": report.Rejected,
+	})
+}
+
+const contentTypeNDJSON = "application/x-ndjson"
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/DenHax/subscription-manager/internal/http/server"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListExchangeRates(c *gin.Context, params server.ListExchangeRatesParams) {
+	rates, err := h.Services.GetRates(c.Request.Context(), params.Currency)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, gin.H{"rates": rates})
+}
+
+func (h *Handler) SetExchangeRate(c *gin.Context, currency server.RateCurrency, month server.RateMonth) {
+	var req server.SetExchangeRateJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
+
+	rate, err := h.Services.SetRate(c.Request.Context(), currency, month, req.Rate)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, rate)
+}
+
+func (h *Handler) DeleteExchangeRate(c *gin.Context, currency server.RateCurrency, month server.RateMonth) {
+	if err := h.Services.DeleteRate(c.Request.Context(), currency, month); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -1,212 +1,372 @@
 package handler
 
 import (
+	"encoding/json"
 	"net/http"
-	"strconv"
 
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/http/server"
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-func (h *Handler) ListSubscriptions(c *gin.Context) {
-	userID := c.Query("user_id")
-	serviceName := c.Query("service_name")
-
-	limitStr := c.Query("limit")
-	offsetStr := c.Query("offset")
-
-	var limit, offset int
-	var err error
-
-	if limitStr != "" {
-		limit, err = strconv.Atoi(limitStr)
-		if err != nil || limit <= 0 {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit parameter"})
-			return
+func (h *Handler) ListSubscriptions(c *gin.Context, params server.ListSubscriptionsParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	filter := models.SubscriptionFilter{
+		ServiceName:       params.ServiceName,
+		ServiceNamePrefix: params.ServiceNamePrefix,
+		MinPrice:          params.MinPrice,
+		MaxPrice:          params.MaxPrice,
+		Status:            (*string)(params.Status),
+		ActiveAt:          params.ActiveAt,
+		StartDateFrom:     params.StartDateFrom,
+		StartDateTo:       params.StartDateTo,
+		EndDateFrom:       params.EndDateFrom,
+		EndDateTo:         params.EndDateTo,
+		IncludeDeleted:    params.IncludeDeleted != nil && *params.IncludeDeleted,
+	}
+	if params.UserId != nil {
+		for _, id := range *params.UserId {
+			filter.UserIDs = append(filter.UserIDs, id.String())
 		}
-	} else {
-		limit = 10
 	}
 
-	if offsetStr != "" {
-		offset, err = strconv.Atoi(offsetStr)
-		if err != nil || offset < 0 {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset parameter"})
-			return
-		}
-	} else {
-		offset = 0
+	page := models.PageRequest{
+		Limit:     limit,
+		Offset:    offset,
+		SkipTotal: params.IncludeTotal != nil && !*params.IncludeTotal,
+	}
+	if params.Cursor != nil {
+		page.Cursor = *params.Cursor
+	}
+	if params.Sort != nil {
+		page.Sort = *params.Sort
 	}
 
-	subscriptions, total, err := h.Services.GetAllSubscriptions(&userID, &serviceName, limit, offset)
+	subscriptions, info, err := h.Services.GetAllSubscriptions(c.Request.Context(), filter, page)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"subscriptions": subscriptions,
-		"total":         total,
-	})
+	resp := gin.H{"subscriptions": subscriptions}
+	if info.Total != nil {
+		resp["total"] = *info.Total
+	}
+	if info.NextCursor != "" {
+		resp["next_cursor"] = info.NextCursor
+	}
+	writeJSON(c, http.StatusOK, resp)
 }
 
-func (h *Handler) CreateSubscription(c *gin.Context) {
-	var req struct {
-		ServiceName string `json:"service_name" binding:"required"`
-		Price       int    `json:"price" binding:"required"`
-		UserID      string `json:"user_id" binding:"required"`
-		StartDate   string `json:"start_date" binding:"required,datetime=01-2006"`
-		EndDate     string `json:"end_date"`
+// ListActiveSubscriptions lists the subscriptions running at a day or in a month, by default the
+// ones active today.
+func (h *Handler) ListActiveSubscriptions(c *gin.Context, params server.ListActiveSubscriptionsParams) {
+	list := server.ListSubscriptionsParams{
+		UserId:       params.UserId,
+		ServiceName:  params.ServiceName,
+		ActiveAt:     params.At,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+		Cursor:       params.Cursor,
+		Sort:         params.Sort,
+		IncludeTotal: params.IncludeTotal,
+	}
+	if params.At == nil {
+		status := server.Active
+		list.Status = &status
 	}
 
+	h.ListSubscriptions(c, list)
+}
+
+func (h *Handler) CreateSubscription(c *gin.Context) {
+	var req server.CreateSubscriptionJSONRequestBody
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		badRequest(c, "", err.Error())
 		return
 	}
 
-	if _, err := uuid.Parse(req.UserID); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user_id format"})
-		return
+	currency := ""
+	if req.Currency != nil {
+		currency = *req.Currency
+	}
+	billingPeriod := ""
+	if req.BillingPeriod != nil {
+		billingPeriod = string(*req.BillingPeriod)
+	}
+	billingInterval := 0
+	if req.BillingInterval != nil {
+		billingInterval = *req.BillingInterval
 	}
 
-	subscription, err := h.Services.CreateSubscrition(req.ServiceName, req.Price, req.UserID, req.StartDate, &req.EndDate)
+	subscription, err := h.Services.CreateSubscrition(c.Request.Context(), req.ServiceName, req.Price, currency, billingPeriod, billingInterval, req.UserId.String(), req.StartDate, req.EndDate)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, subscription)
+	setETag(c, subscription.Version)
+	writeJSON(c, http.StatusCreated, subscription)
 }
 
-func (h *Handler) GetSubscriptionByID(c *gin.Context) {
-	id := c.Param("id")
+func (h *Handler) GetSubscriptionByID(c *gin.Context, id server.SubscriptionID, params server.GetSubscriptionByIDParams) {
+	subscription, err := h.Services.Subscription(c.Request.Context(), id.String())
+	if err != nil {
+		writeError(c, err)
+		return
+	}
 
-	if _, err := uuid.Parse(id); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid subscription id format"})
+	setETag(c, subscription.Version)
+	if noneMatch(params.IfNoneMatch, subscription.Version) {
+		c.Status(http.StatusNotModified)
 		return
 	}
 
-	subscription, err := h.Services.Subscription(id)
+	writeJSON(c, http.StatusOK, subscription)
+}
+
+// UpdateSubscription applies a JSON merge patch: absent fields are kept and a null end_date
+// clears it, which the service layer expects as an empty string.
+func (h *Handler) UpdateSubscription(c *gin.Context, id server.SubscriptionID, params server.UpdateSubscriptionParams) {
+	versions, ok := requireIfMatch(c, params.IfMatch)
+	if !ok {
+		return
+	}
+
+	body, err := c.GetRawData()
 	if err != nil {
-		if err.Error() == "subscription not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "subscription not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		badRequest(c, "", err.Error())
 		return
 	}
 
-	c.JSON(http.StatusOK, subscription)
-}
+	var req server.UpdateSubscriptionApplicationMergePatchPlusJSONRequestBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
 
-func (h *Handler) UpdateSubscription(c *gin.Context) {
-	id := c.Param("id")
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
 
-	if _, err := uuid.Parse(id); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid subscription id format"})
+	subscriptionID := id.String()
+	subscription, err := h.Services.UpdateSubscription(c.Request.Context(), &subscriptionID, req.ServiceName, req.Price, req.Currency, req.PriceEffectiveFrom, (*string)(req.BillingPeriod), req.BillingInterval, uuidString(req.UserId), req.StartDate, patchedEndDate(req.EndDate, fields), versions)
+	if err != nil {
+		writeError(c, err)
 		return
 	}
 
-	var req struct {
-		ServiceName string `json:"service_name"`
-		Price       int    `json:"price"`
-		UserID      string `json:"user_id"`
-		StartDate   string `json:"start_date"`
-		EndDate     string `json:"end_date"`
+	setETag(c, subscription.Version)
+	writeJSON(c, http.StatusOK, subscription)
+}
+
+// ReplaceSubscription overwrites every field, so an omitted end_date makes the subscription
+// open-ended and omitted currency and billing fields fall back to their defaults.
+func (h *Handler) ReplaceSubscription(c *gin.Context, id server.SubscriptionID, params server.ReplaceSubscriptionParams) {
+	versions, ok := requireIfMatch(c, params.IfMatch)
+	if !ok {
+		return
 	}
 
+	var req server.ReplaceSubscriptionJSONRequestBody
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		badRequest(c, "", err.Error())
 		return
 	}
 
-	if req.UserID != "" {
-		if _, err := uuid.Parse(req.UserID); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user_id format"})
-			return
-		}
+	endDate := ""
+	if req.EndDate != nil {
+		endDate = *req.EndDate
+	}
+	currency := models.BaseCurrency
+	if req.Currency != nil {
+		currency = *req.Currency
+	}
+	billingPeriod := models.BillingMonthly
+	if req.BillingPeriod != nil {
+		billingPeriod = string(*req.BillingPeriod)
+	}
+	billingInterval := 1
+	if req.BillingInterval != nil {
+		billingInterval = *req.BillingInterval
 	}
 
-	subscription, err := h.Services.UpdateSubscription(&id, &req.ServiceName, &req.Price, &req.UserID, &req.StartDate, &req.EndDate)
+	subscriptionID := id.String()
+	userID := req.UserId.String()
+	subscription, err := h.Services.UpdateSubscription(c.Request.Context(), &subscriptionID, &req.ServiceName, &req.Price, &currency, nil, &billingPeriod, &billingInterval, &userID, &req.StartDate, &endDate, versions)
 	if err != nil {
-		if err.Error() == "subscription not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "subscription not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, subscription)
+	setETag(c, subscription.Version)
+	writeJSON(c, http.StatusOK, subscription)
 }
 
-func (h *Handler) DeleteSubscription(c *gin.Context) {
-	id := c.Param("id")
-
-	if _, err := uuid.Parse(id); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid subscription id format"})
+func (h *Handler) DeleteSubscription(c *gin.Context, id server.SubscriptionID, params server.DeleteSubscriptionParams) {
+	versions, ok := requireIfMatch(c, params.IfMatch)
+	if !ok {
 		return
 	}
 
-	err := h.Services.DeleteSubscription(id)
+	err := h.Services.DeleteSubscription(c.Request.Context(), id.String(), versions)
 	if err != nil {
-		if err.Error() == "subscription not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "subscription not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
-func (h *Handler) GetSubscriptionSummary(c *gin.Context) {
-	startDate := c.Query("start_date")
-	if startDate == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "start_date is required"})
+func (h *Handler) RestoreSubscription(c *gin.Context, id server.SubscriptionID) {
+	subscription, err := h.Services.RestoreSubscription(c.Request.Context(), id.String())
+	if err != nil {
+		writeError(c, err)
 		return
 	}
 
-	endDate := c.Query("end_date")
-	if endDate == "" {
-		endDate = startDate
+	setETag(c, subscription.Version)
+	writeJSON(c, http.StatusOK, subscription)
+}
+
+func (h *Handler) GetSubscriptionHistory(c *gin.Context, id server.SubscriptionID, params server.GetSubscriptionHistoryParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	events, total, err := h.Services.SubscriptionHistory(c.Request.Context(), id.String(), limit, offset)
+	if err != nil {
+		writeError(c, err)
+		return
 	}
 
-	userID := c.Query("user_id")
-	serviceName := c.Query("service_name")
+	writeJSON(c, http.StatusOK, gin.H{
+		"events": events,
+		"total":  total,
+	})
+}
 
-	if userID != "" {
-		if _, err := uuid.Parse(userID); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user_id format"})
-			return
-		}
+func (h *Handler) GetSubscriptionPrices(c *gin.Context, id server.SubscriptionID) {
+	prices, err := h.Services.SubscriptionPrices(c.Request.Context(), id.String())
+	if err != nil {
+		writeError(c, err)
+		return
 	}
 
-	totalCost, err := h.Services.SummarySubscription(startDate, endDate, &userID, &serviceName)
+	writeJSON(c, http.StatusOK, gin.H{"prices": prices})
+}
+
+func (h *Handler) PurgeSubscriptions(c *gin.Context) {
+	purged, err := h.Services.PurgeSubscriptions(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
+	writeJSON(c, http.StatusOK, server.PurgeResult{Purged: purged})
+}
+
+func (h *Handler) GetSubscriptionSummary(c *gin.Context, params server.GetSubscriptionSummaryParams) {
+	startDate := params.StartDate
+	endDate := startDate
+	if params.EndDate != nil {
+		endDate = *params.EndDate
+	}
+
+	userFilter := uuidString(params.UserId)
+	serviceFilter := params.ServiceName
+	includeDeleted := params.IncludeDeleted != nil && *params.IncludeDeleted
+	currency := models.BaseCurrency
+	if params.Currency != nil {
+		currency = *params.Currency
+	}
+	proration := models.ProrationNone
+	if params.Proration != nil {
+		proration = string(*params.Proration)
+	}
+
 	response := gin.H{
-		"total_cost": totalCost,
+		"currency":  currency,
+		"proration": proration,
 		"period": gin.H{
 			"start_date": startDate,
 			"end_date":   endDate,
 		},
 	}
 
-	if userID != "" || serviceName != "" {
+	if userFilter != nil || serviceFilter != nil {
 		filters := gin.H{}
-		if userID != "" {
-			filters["user_id"] = userID
+		if userFilter != nil {
+			filters["user_id"] = *userFilter
 		}
-		if serviceName != "" {
-			filters["service_name"] = serviceName
+		if serviceFilter != nil {
+			filters["service_name"] = *serviceFilter
 		}
 		response["filters"] = filters
 	}
 
-	c.JSON(http.StatusOK, response)
+	groupBy := ""
+	if params.GroupBy != nil {
+		groupBy = string(*params.GroupBy)
+	}
+
+	switch groupBy {
+	case "":
+		summary, err := h.Services.SummarySubscription(c.Request.Context(), startDate, endDate, currency, proration, userFilter, serviceFilter, includeDeleted)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		response["total_cost"] = summary.TotalCost
+		response["months"] = summary.Months
+		response["subscription_months"] = summary.SubscriptionMonths
+		response["charges"] = summary.Charges
+	case models.GroupByMonth:
+		months, totalCost, err := h.Services.MonthlySummarySubscription(c.Request.Context(), startDate, endDate, currency, proration, userFilter, serviceFilter, includeDeleted)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		response["group_by"] = groupBy
+		response["total_cost"] = totalCost
+		response["breakdown"] = months
+	case models.GroupByServiceName, models.GroupByUserID:
+		groups, totalCost, err := h.Services.GroupedSummarySubscription(c.Request.Context(), startDate, endDate, groupBy, currency, proration, userFilter, serviceFilter, includeDeleted)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		response["group_by"] = groupBy
+		response["total_cost"] = totalCost
+		response["groups"] = groups
+	default:
+		badRequest(c, "group_by", "invalid group_by parameter")
+		return
+	}
+
+	writeJSON(c, http.StatusOK, response)
+}
+
+// patchedEndDate returns the end_date of a merge patch given its raw fields, an explicit null
+// becomes the empty string the service layer reads as clearing it.
+func patchedEndDate(endDate *string, fields map[string]json.RawMessage) *string {
+	if raw, ok := fields["end_date"]; ok && string(raw) == "null" {
+		cleared := ""
+		return &cleared
+	}
+	return endDate
+}
+
+// uuidString converts an optional UUID parameter into the string form used by the service layer.
+func uuidString(id *openapi_types.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
 }
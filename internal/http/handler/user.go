@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/DenHax/subscription-manager/internal/http/server"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListUsers(c *gin.Context, params server.ListUsersParams) {
+	limit, offset := pagination(params.Limit, params.Offset)
+
+	users, total, err := h.Services.GetAllUsers(c.Request.Context(), limit, offset)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, gin.H{
+		"users": users,
+		"total": total,
+	})
+}
+
+func (h *Handler) CreateUser(c *gin.Context) {
+	var req server.CreateUserJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		badRequest(c, "", err.Error())
+		return
+	}
+
+	user, err := h.Services.CreateUser(c.Request.Context(), uuidString(req.UserId), req.Username)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusCreated, user)
+}
+
+func (h *Handler) GetUser(c *gin.Context, userID server.UserID) {
+	user, err := h.Services.User(c.Request.Context(), userID.String())
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, user)
+}
+
+func (h *Handler) DeleteUser(c *gin.Context, userID server.UserID, params server.DeleteUserParams) {
+	cascade := params.Cascade != nil && *params.Cascade
+
+	if err := h.Services.DeleteUser(c.Request.Context(), userID.String(), cascade); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) ListUserSubscriptions(c *gin.Context, userID server.UserID, params server.ListUserSubscriptionsParams) {
+	// An unknown user is a 404 rather than an empty list
+	if _, err := h.Services.User(c.Request.Context(), userID.String()); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	h.ListSubscriptions(c, server.ListSubscriptionsParams{
+		UserId:            &server.UserIDsQuery{userID},
+		ServiceName:       params.ServiceName,
+		ServiceNamePrefix: params.ServiceNamePrefix,
+		MinPrice:          params.MinPrice,
+		MaxPrice:          params.MaxPrice,
+		Status:            params.Status,
+		ActiveAt:          params.ActiveAt,
+		StartDateFrom:     params.StartDateFrom,
+		StartDateTo:       params.StartDateTo,
+		EndDateFrom:       params.EndDateFrom,
+		EndDateTo:         params.EndDateTo,
+		IncludeDeleted:    params.IncludeDeleted,
+		Limit:             params.Limit,
+		Offset:            params.Offset,
+		Cursor:            params.Cursor,
+		Sort:              params.Sort,
+		IncludeTotal:      params.IncludeTotal,
+	})
+}
+
+func (h *Handler) GetUserSummary(c *gin.Context, userID server.UserID, params server.GetUserSummaryParams) {
+	if _, err := h.Services.User(c.Request.Context(), userID.String()); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	h.GetSubscriptionSummary(c, server.GetSubscriptionSummaryParams{
+		StartDate:      params.StartDate,
+		EndDate:        params.EndDate,
+		Currency:       params.Currency,
+		Proration:      params.Proration,
+		UserId:         &userID,
+		ServiceName:    params.ServiceName,
+		GroupBy:        params.GroupBy,
+		IncludeDeleted: params.IncludeDeleted,
+	})
+}
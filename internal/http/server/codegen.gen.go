@@ -1,33 +1,2332 @@
 // Package server provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version 2.5.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
 package server
 
 import (
 	"bytes"
 	"compress/gzip"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for BatchMode.
+const (
+	Atomic  BatchMode = "atomic"
+	Partial BatchMode = "partial"
+)
+
+// Defines values for BatchOperationOp.
+const (
+	Create BatchOperationOp = "create"
+	Delete BatchOperationOp = "delete"
+	Update BatchOperationOp = "update"
+)
+
+// Defines values for BillingPeriod.
+const (
+	Monthly   BillingPeriod = "monthly"
+	Quarterly BillingPeriod = "quarterly"
+	Weekly    BillingPeriod = "weekly"
+	Yearly    BillingPeriod = "yearly"
+)
+
+// Defines values for ErrorDetailCode.
+const (
+	ErrorDetailCodeAborted              ErrorDetailCode = "aborted"
+	ErrorDetailCodeBadRequest           ErrorDetailCode = "bad_request"
+	ErrorDetailCodeCanceled             ErrorDetailCode = "canceled"
+	ErrorDetailCodeConflict             ErrorDetailCode = "conflict"
+	ErrorDetailCodeInternalError        ErrorDetailCode = "internal_error"
+	ErrorDetailCodeNotFound             ErrorDetailCode = "not_found"
+	ErrorDetailCodePreconditionFailed   ErrorDetailCode = "precondition_failed"
+	ErrorDetailCodePreconditionRequired ErrorDetailCode = "precondition_required"
+	ErrorDetailCodeTimeout              ErrorDetailCode = "timeout"
+	ErrorDetailCodeUnknownReference     ErrorDetailCode = "unknown_reference"
+	ErrorDetailCodeValidationError      ErrorDetailCode = "validation_error"
+)
+
+// Defines values for IfMatchAny.
+const (
+	Asterisk IfMatchAny = "*"
+)
+
+// Defines values for SubscriptionEventAction.
+const (
+	Created  SubscriptionEventAction = "created"
+	Deleted  SubscriptionEventAction = "deleted"
+	Purged   SubscriptionEventAction = "purged"
+	Restored SubscriptionEventAction = "restored"
+	Updated  SubscriptionEventAction = "updated"
+)
+
+// Defines values for SubscriptionStatus.
+const (
+	Active   SubscriptionStatus = "active"
+	Expired  SubscriptionStatus = "expired"
+	Upcoming SubscriptionStatus = "upcoming"
+)
+
+// Defines values for SummaryGroupBy.
+const (
+	SummaryGroupByMonth       SummaryGroupBy = "month"
+	SummaryGroupByServiceName SummaryGroupBy = "service_name"
+	SummaryGroupByUserId      SummaryGroupBy = "user_id"
+)
+
+// Defines values for SummaryProration.
+const (
+	Daily SummaryProration = "daily"
+	None  SummaryProration = "none"
+)
+
+// BatchMode defines model for BatchMode.
+type BatchMode string
+
+// BatchOperation defines model for BatchOperation.
+type BatchOperation struct {
+	// IfMatch Apply only while the subscription is at this version, like the If-Match header, or
+	// "*" to accept any version. Required for updates and deletes, an operation without it
+	// fails with precondition_required.
+	IfMatch *BatchOperation_IfMatch `json:"if_match,omitempty"`
+	Op      BatchOperationOp        `json:"op"`
+
+	// Subscription Fields of a create, which requires the same fields as creating a single
+	// subscription, or merge patch of an update.
+	Subscription *UpdateSubscriptionRequest `json:"subscription,omitempty"`
+
+	// SubscriptionId Subscription to update or delete.
+	SubscriptionId *openapi_types.UUID `json:"subscription_id,omitempty"`
+}
+
+// BatchOperation_IfMatch Apply only while the subscription is at this version, like the If-Match header, or
+// "*" to accept any version. Required for updates and deletes, an operation without it
+// fails with precondition_required.
+type BatchOperation_IfMatch struct {
+	union json.RawMessage
+}
+
+// BatchOperationOp defines model for BatchOperation.Op.
+type BatchOperationOp string
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	Mode       *BatchMode       `json:"mode,omitempty"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	Failed    int           `json:"failed"`
+	Results   []BatchResult `json:"results"`
+	Succeeded int           `json:"succeeded"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	Error *ErrorDetail `json:"error,omitempty"`
+
+	// Index Position of the operation in the request.
+	Index int `json:"index"`
+
+	// Status HTTP status the operation would have had as a single request.
+	Status       int           `json:"status"`
+	Subscription *Subscription `json:"subscription,omitempty"`
+}
+
+// BillingPeriod Unit of the billing interval, the price is charged once per interval.
+type BillingPeriod string
+
+// CreateServiceRequest defines model for CreateServiceRequest.
+type CreateServiceRequest struct {
+	Category *string `json:"category,omitempty"`
+
+	// Currency ISO 4217 currency code.
+	Currency     *Currency `json:"currency,omitempty"`
+	DefaultPrice *int      `json:"default_price,omitempty"`
+	DisplayName  *string   `json:"display_name,omitempty"`
+	ServiceName  string    `json:"service_name"`
+	VendorUrl    *string   `json:"vendor_url,omitempty"`
+}
+
+// CreateSubscriptionRequest defines model for CreateSubscriptionRequest.
+type CreateSubscriptionRequest struct {
+	// BillingInterval Number of billing periods between charges, defaults to 1.
+	BillingInterval *int `json:"billing_interval,omitempty"`
+
+	// BillingPeriod Defaults to monthly.
+	BillingPeriod *BillingPeriod `json:"billing_period,omitempty"`
+
+	// Currency Currency of the price, defaults to RUB.
+	Currency *Currency `json:"currency,omitempty"`
+
+	// EndDate ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+	// and for its last day as an end.
+	EndDate     *DateOrMonth `json:"end_date,omitempty"`
+	Price       int          `json:"price"`
+	ServiceName string       `json:"service_name"`
+
+	// StartDate ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+	// and for its last day as an end.
+	StartDate DateOrMonth        `json:"start_date"`
+	UserId    openapi_types.UUID `json:"user_id"`
+}
+
+// CreateUserRequest defines model for CreateUserRequest.
+type CreateUserRequest struct {
+	// UserId Generated when omitted.
+	UserId   *openapi_types.UUID `json:"user_id,omitempty"`
+	Username string              `json:"username"`
+}
+
+// Currency ISO 4217 currency code.
+type Currency = string
+
+// DateOrMonth ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type DateOrMonth = string
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Code Stable machine-readable error code.
+	Code    ErrorDetailCode `json:"code"`
+	Field   *string         `json:"field,omitempty"`
+	Message string          `json:"message"`
+}
+
+// ErrorDetailCode Stable machine-readable error code.
+type ErrorDetailCode string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	// Currency ISO 4217 currency code.
+	Currency Currency `json:"currency"`
+
+	// EffectiveFrom First day the rate applies to.
+	EffectiveFrom openapi_types.Date `json:"effective_from"`
+
+	// Rate Value of one unit of the currency in RUB.
+	Rate float64 `json:"rate"`
+}
+
+// ExchangeRateList defines model for ExchangeRateList.
+type ExchangeRateList struct {
+	Rates []ExchangeRate `json:"rates"`
+}
+
+// GroupCost defines model for GroupCost.
+type GroupCost struct {
+	Cost int `json:"cost"`
+
+	// Key Service name or user ID, depending on group_by.
+	Key string `json:"key"`
+
+	// Share Fraction of the total cost, between 0 and 1.
+	Share         float64 `json:"share"`
+	Subscriptions int     `json:"subscriptions"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// IfMatchAny Accepts any version.
+type IfMatchAny string
+
+// IfMatchVersion Version the subscription must be at.
+type IfMatchVersion = int
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Accepted int  `json:"accepted"`
+	DryRun   bool `json:"dry_run"`
+	Rejected int  `json:"rejected"`
+}
+
+// ImportRowResult defines model for ImportRowResult.
+type ImportRowResult struct {
+	Error *ErrorDetail `json:"error,omitempty"`
+
+	// Line Line of the row in the file, the header being line 1.
+	Line int `json:"line"`
+
+	// Status HTTP status the row would have had as a single create.
+	Status int `json:"status"`
+
+	// SubscriptionId Subscription created for the row, left out on dry runs.
+	SubscriptionId *openapi_types.UUID `json:"subscription_id,omitempty"`
+}
+
+// MonthYear defines model for MonthYear.
+type MonthYear = string
+
+// MonthlyCost defines model for MonthlyCost.
+type MonthlyCost struct {
+	Cost  int       `json:"cost"`
+	Month MonthYear `json:"month"`
+
+	// Subscriptions Number of subscriptions charged in the month.
+	Subscriptions int `json:"subscriptions"`
+}
+
+// Period defines model for Period.
+type Period struct {
+	// EndDate ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+	// and for its last day as an end.
+	EndDate DateOrMonth `json:"end_date"`
+
+	// StartDate ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+	// and for its last day as an end.
+	StartDate DateOrMonth `json:"start_date"`
+}
+
+// PricePeriod defines model for PricePeriod.
+type PricePeriod struct {
+	// Currency ISO 4217 currency code.
+	Currency      Currency           `json:"currency"`
+	EffectiveFrom openapi_types.Date `json:"effective_from"`
+	Price         int                `json:"price"`
+}
+
+// PricePeriodList defines model for PricePeriodList.
+type PricePeriodList struct {
+	Prices []PricePeriod `json:"prices"`
+}
+
+// PurgeResult defines model for PurgeResult.
+type PurgeResult struct {
+	Purged int `json:"purged"`
+}
+
+// Service defines model for Service.
+type Service struct {
+	Category *string `json:"category"`
+
+	// Currency ISO 4217 currency code.
+	Currency Currency `json:"currency"`
+
+	// DefaultPrice Default monthly price.
+	DefaultPrice *int    `json:"default_price"`
+	DisplayName  *string `json:"display_name"`
+	ServiceName  string  `json:"service_name"`
+	VendorUrl    *string `json:"vendor_url"`
+}
+
+// ServiceList defines model for ServiceList.
+type ServiceList struct {
+	Services []Service `json:"services"`
+	Total    int       `json:"total"`
+}
+
+// SetExchangeRateRequest defines model for SetExchangeRateRequest.
+type SetExchangeRateRequest struct {
+	Rate float64 `json:"rate"`
+}
+
+// Subscription defines model for Subscription.
+type Subscription struct {
+	// BillingInterval Number of billing periods between charges.
+	BillingInterval int `json:"billing_interval"`
+
+	// BillingPeriod Unit of the billing interval, the price is charged once per interval.
+	BillingPeriod BillingPeriod `json:"billing_period"`
+
+	// Currency ISO 4217 currency code.
+	Currency Currency `json:"currency"`
+
+	// DeletedAt Set once the subscription is deleted, absent otherwise.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// EndDate Last day of the subscription, inclusive. Null for open-ended subscriptions.
+	EndDate *openapi_types.Date `json:"end_date"`
+
+	// Price Current price per billing interval, see the prices endpoint for earlier ones.
+	Price       int                `json:"price"`
+	ServiceName string             `json:"service_name"`
+	StartDate   openapi_types.Date `json:"start_date"`
+
+	// Status Derived from the dates relative to today: upcoming before start_date, expired after
+	// end_date, active in between. Absent in event snapshots.
+	Status         *SubscriptionStatus `json:"status,omitempty"`
+	SubscriptionId openapi_types.UUID  `json:"subscription_id"`
+	UserId         openapi_types.UUID  `json:"user_id"`
+
+	// Version Incremented on every change, exposed as the ETag.
+	Version int `json:"version"`
+}
+
+// SubscriptionEvent defines model for SubscriptionEvent.
+type SubscriptionEvent struct {
+	// Action A purged event keeps the last state of the subscription as before.
+	Action SubscriptionEventAction `json:"action"`
+
+	// Actor Value of the X-Actor request header, "system" for changes made outside a request.
+	Actor          string             `json:"actor"`
+	After          *Subscription      `json:"after"`
+	Before         *Subscription      `json:"before"`
+	CreatedAt      time.Time          `json:"created_at"`
+	EventId        int64              `json:"event_id"`
+	RequestId      *string            `json:"request_id"`
+	SubscriptionId openapi_types.UUID `json:"subscription_id"`
+}
+
+// SubscriptionEventAction A purged event keeps the last state of the subscription as before.
+type SubscriptionEventAction string
+
+// SubscriptionEventList defines model for SubscriptionEventList.
+type SubscriptionEventList struct {
+	Events []SubscriptionEvent `json:"events"`
+	Total  int                 `json:"total"`
+}
+
+// SubscriptionList defines model for SubscriptionList.
+type SubscriptionList struct {
+	// NextCursor Cursor of the following page, omitted on the last page.
+	NextCursor    *string        `json:"next_cursor,omitempty"`
+	Subscriptions []Subscription `json:"subscriptions"`
+
+	// Total Omitted when include_total is false.
+	Total *int `json:"total,omitempty"`
+}
+
+// SubscriptionSort defines model for SubscriptionSort.
+type SubscriptionSort = string
+
+// SubscriptionStatus Derived from the dates relative to today: upcoming before start_date, expired after
+// end_date, active in between. Absent in event snapshots.
+type SubscriptionStatus string
+
+// Summary defines model for Summary.
+type Summary struct {
+	Breakdown *[]MonthlyCost `json:"breakdown,omitempty"`
+
+	// Charges Number of billing dates inside the period.
+	Charges *int `json:"charges,omitempty"`
+
+	// Currency ISO 4217 currency code.
+	Currency Currency        `json:"currency"`
+	Filters  *SummaryFilters `json:"filters,omitempty"`
+	GroupBy  *SummaryGroupBy `json:"group_by,omitempty"`
+	Groups   *[]GroupCost    `json:"groups,omitempty"`
+
+	// Months Number of calendar months in the period.
+	Months    *int              `json:"months,omitempty"`
+	Period    Period            `json:"period"`
+	Proration *SummaryProration `json:"proration,omitempty"`
+
+	// SubscriptionMonths Sum of months each subscription was active in the period.
+	SubscriptionMonths *int `json:"subscription_months,omitempty"`
+	TotalCost          int  `json:"total_cost"`
+}
+
+// SummaryFilters defines model for SummaryFilters.
+type SummaryFilters struct {
+	ServiceName *string             `json:"service_name,omitempty"`
+	UserId      *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// SummaryGroupBy defines model for SummaryGroupBy.
+type SummaryGroupBy string
+
+// SummaryProration defines model for SummaryProration.
+type SummaryProration string
+
+// UpdateServiceRequest defines model for UpdateServiceRequest.
+type UpdateServiceRequest struct {
+	Category *string `json:"category,omitempty"`
+
+	// Currency ISO 4217 currency code.
+	Currency     *Currency `json:"currency,omitempty"`
+	DefaultPrice *int      `json:"default_price,omitempty"`
+	DisplayName  *string   `json:"display_name,omitempty"`
+
+	// ServiceName New name of the service.
+	ServiceName *string `json:"service_name,omitempty"`
+	VendorUrl   *string `json:"vendor_url,omitempty"`
+}
+
+// UpdateSubscriptionRequest Merge patch of a subscription, only end_date may be null.
+type UpdateSubscriptionRequest struct {
+	BillingInterval *int `json:"billing_interval,omitempty"`
+
+	// BillingPeriod Unit of the billing interval, the price is charged once per interval.
+	BillingPeriod *BillingPeriod `json:"billing_period,omitempty"`
+
+	// Currency ISO 4217 currency code.
+	Currency *Currency    `json:"currency,omitempty"`
+	EndDate  *DateOrMonth `json:"end_date,omitempty"`
+	Price    *int         `json:"price,omitempty"`
+
+	// PriceEffectiveFrom First month the new price or currency applies to, defaults to the current month. Requires price or currency.
+	PriceEffectiveFrom *DateOrMonth `json:"price_effective_from,omitempty"`
+	ServiceName        *string      `json:"service_name,omitempty"`
+
+	// StartDate ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+	// and for its last day as an end.
+	StartDate *DateOrMonth        `json:"start_date,omitempty"`
+	UserId    *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	UserId   openapi_types.UUID `json:"user_id"`
+	Username string             `json:"username"`
+}
+
+// UserList defines model for UserList.
+type UserList struct {
+	Total int    `json:"total"`
+	Users []User `json:"users"`
+}
+
+// ActiveAt ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type ActiveAt = DateOrMonth
+
+// At ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type At = DateOrMonth
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// Delimiter defines model for Delimiter.
+type Delimiter = string
+
+// DryRun defines model for DryRun.
+type DryRun = bool
+
+// EndDateFrom ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type EndDateFrom = DateOrMonth
+
+// EndDateTo ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type EndDateTo = DateOrMonth
+
+// GroupBy defines model for GroupBy.
+type GroupBy = SummaryGroupBy
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// ImportColumn defines model for ImportColumn.
+type ImportColumn = []string
+
+// IncludeDeleted defines model for IncludeDeleted.
+type IncludeDeleted = bool
+
+// IncludeTotal defines model for IncludeTotal.
+type IncludeTotal = bool
+
+// Limit defines model for Limit.
+type Limit = int
+
+// MaxPrice defines model for MaxPrice.
+type MaxPrice = int
+
+// MinPrice defines model for MinPrice.
+type MinPrice = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// PeriodEnd ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type PeriodEnd = DateOrMonth
+
+// PeriodStart ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type PeriodStart = DateOrMonth
+
+// Proration defines model for Proration.
+type Proration = SummaryProration
+
+// RateCurrency ISO 4217 currency code.
+type RateCurrency = Currency
+
+// RateMonth defines model for RateMonth.
+type RateMonth = MonthYear
+
+// ServiceName defines model for ServiceName.
+type ServiceName = string
+
+// ServiceNamePrefix defines model for ServiceNamePrefix.
+type ServiceNamePrefix = string
+
+// ServiceNameQuery defines model for ServiceNameQuery.
+type ServiceNameQuery = string
+
+// StartDateFrom ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type StartDateFrom = DateOrMonth
+
+// StartDateTo ISO 8601 date, or a legacy MM-YYYY month standing for its first day as a start
+// and for its last day as an end.
+type StartDateTo = DateOrMonth
+
+// StatusQuery Derived from the dates relative to today: upcoming before start_date, expired after
+// end_date, active in between. Absent in event snapshots.
+type StatusQuery = SubscriptionStatus
+
+// SubscriptionID defines model for SubscriptionID.
+type SubscriptionID = openapi_types.UUID
+
+// SummaryCurrency ISO 4217 currency code.
+type SummaryCurrency = Currency
+
+// UserID defines model for UserID.
+type UserID = openapi_types.UUID
+
+// UserIDQuery defines model for UserIDQuery.
+type UserIDQuery = openapi_types.UUID
+
+// UserIDsQuery defines model for UserIDsQuery.
+type UserIDsQuery = []openapi_types.UUID
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// Conflict defines model for Conflict.
+type Conflict = ErrorResponse
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = ErrorResponse
+
+// PreconditionRequired defines model for PreconditionRequired.
+type PreconditionRequired = ErrorResponse
+
+// UnprocessableEntity defines model for UnprocessableEntity.
+type UnprocessableEntity = ErrorResponse
+
+// ListExchangeRatesParams defines parameters for ListExchangeRates.
+type ListExchangeRatesParams struct {
+	Currency *Currency `form:"currency,omitempty" json:"currency,omitempty"`
+}
+
+// ListServicesParams defines parameters for ListServices.
+type ListServicesParams struct {
+	// Limit Rows to return, at most 100 per page.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Cannot be combined with cursor.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListSubscriptionsParams defines parameters for ListSubscriptions.
+type ListSubscriptionsParams struct {
+	// UserId Repeat to match any of several users.
+	UserId      *UserIDsQuery     `form:"user_id,omitempty" json:"user_id,omitempty"`
+	ServiceName *ServiceNameQuery `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// ServiceNamePrefix Case-insensitive prefix of the service name.
+	ServiceNamePrefix *ServiceNamePrefix `form:"service_name_prefix,omitempty" json:"service_name_prefix,omitempty"`
+
+	// MinPrice Lowest current price, in the subscription's own currency.
+	MinPrice *MinPrice `form:"min_price,omitempty" json:"min_price,omitempty"`
+
+	// MaxPrice Highest current price, in the subscription's own currency.
+	MaxPrice *MaxPrice    `form:"max_price,omitempty" json:"max_price,omitempty"`
+	Status   *StatusQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// ActiveAt Only subscriptions running on this day, or at any time in a MM-YYYY month.
+	ActiveAt *ActiveAt `form:"active_at,omitempty" json:"active_at,omitempty"`
+
+	// StartDateFrom Earliest start date, inclusive.
+	StartDateFrom *StartDateFrom `form:"start_date_from,omitempty" json:"start_date_from,omitempty"`
+
+	// StartDateTo Latest start date, inclusive.
+	StartDateTo *StartDateTo `form:"start_date_to,omitempty" json:"start_date_to,omitempty"`
+
+	// EndDateFrom Earliest end date, inclusive. Subscriptions without an end date never match.
+	EndDateFrom *EndDateFrom `form:"end_date_from,omitempty" json:"end_date_from,omitempty"`
+
+	// EndDateTo Latest end date, inclusive. Subscriptions without an end date never match.
+	EndDateTo *EndDateTo `form:"end_date_to,omitempty" json:"end_date_to,omitempty"`
+
+	// IncludeDeleted Also return deleted subscriptions that have not been purged yet.
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Limit Rows to return, at most 100 per page.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Cannot be combined with cursor.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Continue after the page that returned this next_cursor. Must be sent with the same sort
+	// the cursor was issued for.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Comma-separated sort keys, each prefixed with "-" for descending order, like
+	// "price,-start_date". Ties are broken by subscription ID.
+	Sort *SubscriptionSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IncludeTotal Count all matching subscriptions. Turn off to skip the count on large lists.
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// ListActiveSubscriptionsParams defines parameters for ListActiveSubscriptions.
+type ListActiveSubscriptionsParams struct {
+	// At Day, or MM-YYYY month, the subscriptions were running in. Defaults to today.
+	At *At `form:"at,omitempty" json:"at,omitempty"`
+
+	// UserId Repeat to match any of several users.
+	UserId      *UserIDsQuery     `form:"user_id,omitempty" json:"user_id,omitempty"`
+	ServiceName *ServiceNameQuery `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// Limit Rows to return, at most 100 per page.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Cannot be combined with cursor.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Continue after the page that returned this next_cursor. Must be sent with the same sort
+	// the cursor was issued for.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Comma-separated sort keys, each prefixed with "-" for descending order, like
+	// "price,-start_date". Ties are broken by subscription ID.
+	Sort *SubscriptionSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IncludeTotal Count all matching subscriptions. Turn off to skip the count on large lists.
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// ImportSubscriptionsParams defines parameters for ImportSubscriptions.
+type ImportSubscriptionsParams struct {
+	// DryRun Validate every row, including against the database, without keeping anything.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// Column Read a field from a differently named column, as field:header, e.g.
+	// column=price:Monthly cost. Repeat for several fields.
+	Column *ImportColumn `form:"column,omitempty" json:"column,omitempty"`
+
+	// Delimiter Field separator, spreadsheets often export with ";".
+	Delimiter *Delimiter `form:"delimiter,omitempty" json:"delimiter,omitempty"`
+}
+
+// GetSubscriptionSummaryParams defines parameters for GetSubscriptionSummary.
+type GetSubscriptionSummaryParams struct {
+	StartDate PeriodStart `form:"start_date" json:"start_date"`
+
+	// EndDate Defaults to start_date.
+	EndDate *PeriodEnd `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Currency Currency to report costs in, defaults to RUB.
+	Currency *SummaryCurrency `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// Proration How cost is attributed to the period: "none" charges the full price on each billing
+	// date inside it, "daily" spreads each billing period's price evenly over its days and
+	// counts the days inside both the period and the subscription.
+	Proration   *Proration        `form:"proration,omitempty" json:"proration,omitempty"`
+	UserId      *UserIDQuery      `form:"user_id,omitempty" json:"user_id,omitempty"`
+	ServiceName *ServiceNameQuery `form:"service_name,omitempty" json:"service_name,omitempty"`
+	GroupBy     *GroupBy          `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// IncludeDeleted Also return deleted subscriptions that have not been purged yet.
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// DeleteSubscriptionParams defines parameters for DeleteSubscription.
+type DeleteSubscriptionParams struct {
+	// IfMatch Apply the change only if the subscription still has one of these ETags. Required on
+	// writes, a request without it fails with 428. Send "*" to apply the change to any
+	// version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetSubscriptionByIDParams defines parameters for GetSubscriptionByID.
+type GetSubscriptionByIDParams struct {
+	// IfNoneMatch Respond with 304 if the subscription still has one of these ETags.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdateSubscriptionParams defines parameters for UpdateSubscription.
+type UpdateSubscriptionParams struct {
+	// IfMatch Apply the change only if the subscription still has one of these ETags. Required on
+	// writes, a request without it fails with 428. Send "*" to apply the change to any
+	// version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ReplaceSubscriptionParams defines parameters for ReplaceSubscription.
+type ReplaceSubscriptionParams struct {
+	// IfMatch Apply the change only if the subscription still has one of these ETags. Required on
+	// writes, a request without it fails with 428. Send "*" to apply the change to any
+	// version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetSubscriptionHistoryParams defines parameters for GetSubscriptionHistory.
+type GetSubscriptionHistoryParams struct {
+	// Limit Rows to return, at most 100 per page.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Cannot be combined with cursor.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	// Limit Rows to return, at most 100 per page.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Cannot be combined with cursor.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// DeleteUserParams defines parameters for DeleteUser.
+type DeleteUserParams struct {
+	Cascade *bool `form:"cascade,omitempty" json:"cascade,omitempty"`
+}
+
+// ListUserSubscriptionsParams defines parameters for ListUserSubscriptions.
+type ListUserSubscriptionsParams struct {
+	ServiceName *ServiceNameQuery `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// ServiceNamePrefix Case-insensitive prefix of the service name.
+	ServiceNamePrefix *ServiceNamePrefix `form:"service_name_prefix,omitempty" json:"service_name_prefix,omitempty"`
+
+	// MinPrice Lowest current price, in the subscription's own currency.
+	MinPrice *MinPrice `form:"min_price,omitempty" json:"min_price,omitempty"`
+
+	// MaxPrice Highest current price, in the subscription's own currency.
+	MaxPrice *MaxPrice    `form:"max_price,omitempty" json:"max_price,omitempty"`
+	Status   *StatusQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// ActiveAt Only subscriptions running on this day, or at any time in a MM-YYYY month.
+	ActiveAt *ActiveAt `form:"active_at,omitempty" json:"active_at,omitempty"`
+
+	// StartDateFrom Earliest start date, inclusive.
+	StartDateFrom *StartDateFrom `form:"start_date_from,omitempty" json:"start_date_from,omitempty"`
+
+	// StartDateTo Latest start date, inclusive.
+	StartDateTo *StartDateTo `form:"start_date_to,omitempty" json:"start_date_to,omitempty"`
+
+	// EndDateFrom Earliest end date, inclusive. Subscriptions without an end date never match.
+	EndDateFrom *EndDateFrom `form:"end_date_from,omitempty" json:"end_date_from,omitempty"`
+
+	// EndDateTo Latest end date, inclusive. Subscriptions without an end date never match.
+	EndDateTo *EndDateTo `form:"end_date_to,omitempty" json:"end_date_to,omitempty"`
+
+	// IncludeDeleted Also return deleted subscriptions that have not been purged yet.
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Limit Rows to return, at most 100 per page.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Cannot be combined with cursor.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Continue after the page that returned this next_cursor. Must be sent with the same sort
+	// the cursor was issued for.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Comma-separated sort keys, each prefixed with "-" for descending order, like
+	// "price,-start_date". Ties are broken by subscription ID.
+	Sort *SubscriptionSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IncludeTotal Count all matching subscriptions. Turn off to skip the count on large lists.
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// GetUserSummaryParams defines parameters for GetUserSummary.
+type GetUserSummaryParams struct {
+	StartDate PeriodStart `form:"start_date" json:"start_date"`
+
+	// EndDate Defaults to start_date.
+	EndDate *PeriodEnd `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Currency Currency to report costs in, defaults to RUB.
+	Currency *SummaryCurrency `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// Proration How cost is attributed to the period: "none" charges the full price on each billing
+	// date inside it, "daily" spreads each billing period's price evenly over its days and
+	// counts the days inside both the period and the subscription.
+	Proration   *Proration        `form:"proration,omitempty" json:"proration,omitempty"`
+	ServiceName *ServiceNameQuery `form:"service_name,omitempty" json:"service_name,omitempty"`
+	GroupBy     *GroupBy          `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// IncludeDeleted Also return deleted subscriptions that have not been purged yet.
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// SetExchangeRateJSONRequestBody defines body for SetExchangeRate for application/json ContentType.
+type SetExchangeRateJSONRequestBody = SetExchangeRateRequest
+
+// CreateServiceJSONRequestBody defines body for CreateService for application/json ContentType.
+type CreateServiceJSONRequestBody = CreateServiceRequest
+
+// UpdateServiceJSONRequestBody defines body for UpdateService for application/json ContentType.
+type UpdateServiceJSONRequestBody = UpdateServiceRequest
+
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = CreateSubscriptionRequest
+
+// UpdateSubscriptionApplicationMergePatchPlusJSONRequestBody defines body for UpdateSubscription for application/merge-patch+json ContentType.
+type UpdateSubscriptionApplicationMergePatchPlusJSONRequestBody = UpdateSubscriptionRequest
+
+// ReplaceSubscriptionJSONRequestBody defines body for ReplaceSubscription for application/json ContentType.
+type ReplaceSubscriptionJSONRequestBody = CreateSubscriptionRequest
+
+// BatchSubscriptionsJSONRequestBody defines body for BatchSubscriptions for application/json ContentType.
+type BatchSubscriptionsJSONRequestBody = BatchRequest
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = CreateUserRequest
+
+// AsIfMatchVersion returns the union data inside the BatchOperation_IfMatch as a IfMatchVersion
+func (t BatchOperation_IfMatch) AsIfMatchVersion() (IfMatchVersion, error) {
+	var body IfMatchVersion
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromIfMatchVersion overwrites any union data inside the BatchOperation_IfMatch as the provided IfMatchVersion
+func (t *BatchOperation_IfMatch) FromIfMatchVersion(v IfMatchVersion) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeIfMatchVersion performs a merge with any union data inside the BatchOperation_IfMatch, using the provided IfMatchVersion
+func (t *BatchOperation_IfMatch) MergeIfMatchVersion(v IfMatchVersion) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsIfMatchAny returns the union data inside the BatchOperation_IfMatch as a IfMatchAny
+func (t BatchOperation_IfMatch) AsIfMatchAny() (IfMatchAny, error) {
+	var body IfMatchAny
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromIfMatchAny overwrites any union data inside the BatchOperation_IfMatch as the provided IfMatchAny
+func (t *BatchOperation_IfMatch) FromIfMatchAny(v IfMatchAny) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeIfMatchAny performs a merge with any union data inside the BatchOperation_IfMatch, using the provided IfMatchAny
+func (t *BatchOperation_IfMatch) MergeIfMatchAny(v IfMatchAny) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t BatchOperation_IfMatch) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *BatchOperation_IfMatch) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Purge deleted subscriptions
+	// (POST /api/v1/admin/subscriptions/purge)
+	PurgeSubscriptions(c *gin.Context)
+	// List exchange rates
+	// (GET /api/v1/exchange-rates)
+	ListExchangeRates(c *gin.Context, params ListExchangeRatesParams)
+	// Delete the rate of a currency starting in a month
+	// (DELETE /api/v1/exchange-rates/{currency}/{month})
+	DeleteExchangeRate(c *gin.Context, currency RateCurrency, month RateMonth)
+	// Set the rate of a currency from a month on
+	// (PUT /api/v1/exchange-rates/{currency}/{month})
+	SetExchangeRate(c *gin.Context, currency RateCurrency, month RateMonth)
+	// List services
+	// (GET /api/v1/services)
+	ListServices(c *gin.Context, params ListServicesParams)
+	// Register service
+	// (POST /api/v1/services)
+	CreateService(c *gin.Context)
+	// Delete service
+	// (DELETE /api/v1/services/{name})
+	DeleteService(c *gin.Context, name ServiceName)
+	// Get service
+	// (GET /api/v1/services/{name})
+	GetService(c *gin.Context, name ServiceName)
+	// Rename service or change its metadata
+	// (PATCH /api/v1/services/{name})
+	UpdateService(c *gin.Context, name ServiceName)
+	// List subscriptions
+	// (GET /api/v1/subscriptions)
+	ListSubscriptions(c *gin.Context, params ListSubscriptionsParams)
+	// Create subscription
+	// (POST /api/v1/subscriptions)
+	CreateSubscription(c *gin.Context)
+	// List subscriptions running at a day or in a month
+	// (GET /api/v1/subscriptions/active)
+	ListActiveSubscriptions(c *gin.Context, params ListActiveSubscriptionsParams)
+	// Import subscriptions from CSV
+	// (POST /api/v1/subscriptions/import)
+	ImportSubscriptions(c *gin.Context, params ImportSubscriptionsParams)
+	// Subscription cost over a period
+	// (GET /api/v1/subscriptions/summary)
+	GetSubscriptionSummary(c *gin.Context, params GetSubscriptionSummaryParams)
+	// Delete subscription
+	// (DELETE /api/v1/subscriptions/{id})
+	DeleteSubscription(c *gin.Context, id SubscriptionID, params DeleteSubscriptionParams)
+	// Get subscription
+	// (GET /api/v1/subscriptions/{id})
+	GetSubscriptionByID(c *gin.Context, id SubscriptionID, params GetSubscriptionByIDParams)
+	// Update subscription
+	// (PATCH /api/v1/subscriptions/{id})
+	UpdateSubscription(c *gin.Context, id SubscriptionID, params UpdateSubscriptionParams)
+	// Replace subscription
+	// (PUT /api/v1/subscriptions/{id})
+	ReplaceSubscription(c *gin.Context, id SubscriptionID, params ReplaceSubscriptionParams)
+	// Subscription change history
+	// (GET /api/v1/subscriptions/{id}/history)
+	GetSubscriptionHistory(c *gin.Context, id SubscriptionID, params GetSubscriptionHistoryParams)
+	// Subscription price periods
+	// (GET /api/v1/subscriptions/{id}/prices)
+	GetSubscriptionPrices(c *gin.Context, id SubscriptionID)
+	// Restore a deleted subscription
+	// (POST /api/v1/subscriptions/{id}/restore)
+	RestoreSubscription(c *gin.Context, id SubscriptionID)
+	// Create, update and delete subscriptions in bulk
+	// (POST /api/v1/subscriptions:batch)
+	BatchSubscriptions(c *gin.Context)
+	// List users
+	// (GET /api/v1/users)
+	ListUsers(c *gin.Context, params ListUsersParams)
+	// Register user
+	// (POST /api/v1/users)
+	CreateUser(c *gin.Context)
+	// Delete user
+	// (DELETE /api/v1/users/{user_id})
+	DeleteUser(c *gin.Context, userId UserID, params DeleteUserParams)
+	// Get user
+	// (GET /api/v1/users/{user_id})
+	GetUser(c *gin.Context, userId UserID)
+	// List subscriptions of a user
+	// (GET /api/v1/users/{user_id}/subscriptions)
+	ListUserSubscriptions(c *gin.Context, userId UserID, params ListUserSubscriptionsParams)
+	// Subscription cost of a user over a period
+	// (GET /api/v1/users/{user_id}/summary)
+	GetUserSummary(c *gin.Context, userId UserID, params GetUserSummaryParams)
+	// Health check
+	// (GET /health)
+	CheckHealth(c *gin.Context)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// PurgeSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) PurgeSubscriptions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PurgeSubscriptions(c)
+}
+
+// ListExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) ListExchangeRates(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExchangeRatesParams
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", c.Request.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currency: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListExchangeRates(c, params)
+}
+
+// DeleteExchangeRate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteExchangeRate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "currency" -------------
+	var currency RateCurrency
+
+	err = runtime.BindStyledParameterWithOptions("simple", "currency", c.Param("currency"), &currency, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currency: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month RateMonth
+
+	err = runtime.BindStyledParameterWithOptions("simple", "month", c.Param("month"), &month, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteExchangeRate(c, currency, month)
+}
+
+// SetExchangeRate operation middleware
+func (siw *ServerInterfaceWrapper) SetExchangeRate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "currency" -------------
+	var currency RateCurrency
+
+	err = runtime.BindStyledParameterWithOptions("simple", "currency", c.Param("currency"), &currency, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currency: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month RateMonth
+
+	err = runtime.BindStyledParameterWithOptions("simple", "month", c.Param("month"), &month, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetExchangeRate(c, currency, month)
+}
+
+// ListServices operation middleware
+func (siw *ServerInterfaceWrapper) ListServices(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServicesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListServices(c, params)
+}
+
+// CreateService operation middleware
+func (siw *ServerInterfaceWrapper) CreateService(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateService(c)
+}
+
+// DeleteService operation middleware
+func (siw *ServerInterfaceWrapper) DeleteService(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ServiceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteService(c, name)
+}
+
+// GetService operation middleware
+func (siw *ServerInterfaceWrapper) GetService(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ServiceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetService(c, name)
+}
+
+// UpdateService operation middleware
+func (siw *ServerInterfaceWrapper) UpdateService(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ServiceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateService(c, name)
+}
+
+// ListSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListSubscriptions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSubscriptionsParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", c.Request.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name_prefix", c.Request.URL.Query(), &params.ServiceNamePrefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_name_prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "min_price" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_price", c.Request.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter min_price: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "max_price" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_price", c.Request.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter max_price: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "active_at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active_at", c.Request.URL.Query(), &params.ActiveAt)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active_at: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "start_date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date_from", c.Request.URL.Query(), &params.StartDateFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "start_date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date_to", c.Request.URL.Query(), &params.StartDateTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date_from", c.Request.URL.Query(), &params.EndDateFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date_to", c.Request.URL.Query(), &params.EndDateTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", c.Request.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deleted: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", c.Request.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_total: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListSubscriptions(c, params)
+}
+
+// CreateSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateSubscription(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateSubscription(c)
+}
+
+// ListActiveSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListActiveSubscriptions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListActiveSubscriptionsParams
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", c.Request.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter at: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", c.Request.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", c.Request.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_total: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListActiveSubscriptions(c, params)
+}
+
+// ImportSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ImportSubscriptions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportSubscriptionsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "column" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "column", c.Request.URL.Query(), &params.Column)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter column: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "delimiter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "delimiter", c.Request.URL.Query(), &params.Delimiter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter delimiter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportSubscriptions(c, params)
+}
+
+// GetSubscriptionSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionSummary(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionSummaryParams
+
+	// ------------- Required query parameter "start_date" -------------
+
+	if paramValue := c.Query("start_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument start_date is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start_date", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", c.Request.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currency: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "proration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "proration", c.Request.URL.Query(), &params.Proration)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter proration: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", c.Request.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", c.Request.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", c.Request.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deleted: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionSummary(c, params)
+}
+
+// DeleteSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id SubscriptionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSubscriptionParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSubscription(c, id, params)
+}
+
+// GetSubscriptionByID operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionByID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id SubscriptionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionByIDParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionByID(c, id, params)
+}
+
+// UpdateSubscription operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id SubscriptionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSubscriptionParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateSubscription(c, id, params)
+}
+
+// ReplaceSubscription operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id SubscriptionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceSubscriptionParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReplaceSubscription(c, id, params)
+}
+
+// GetSubscriptionHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id SubscriptionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionHistory(c, id, params)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// GetSubscriptionPrices operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionPrices(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id SubscriptionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionPrices(c, id)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// RestoreSubscription operation middleware
+func (siw *ServerInterfaceWrapper) RestoreSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id SubscriptionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreSubscription(c, id)
+}
+
+// BatchSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) BatchSubscriptions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BatchSubscriptions(c)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListUsers(c, params)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateUser(c)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUserParams
+
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", c.Request.URL.Query(), &params.Cascade)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cascade: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUser(c, userId, params)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUser(c, userId)
+}
+
+// ListUserSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListUserSubscriptions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserSubscriptionsParams
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", c.Request.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name_prefix", c.Request.URL.Query(), &params.ServiceNamePrefix)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_name_prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "min_price" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_price", c.Request.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter min_price: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "max_price" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_price", c.Request.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter max_price: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "active_at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active_at", c.Request.URL.Query(), &params.ActiveAt)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active_at: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "start_date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date_from", c.Request.URL.Query(), &params.StartDateFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "start_date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date_to", c.Request.URL.Query(), &params.StartDateTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date_from", c.Request.URL.Query(), &params.EndDateFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date_to", c.Request.URL.Query(), &params.EndDateTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", c.Request.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deleted: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", c.Request.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_total: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListUserSubscriptions(c, userId, params)
+}
+
+// GetUserSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSummary(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserSummaryParams
+
+	// ------------- Required query parameter "start_date" -------------
+
+	if paramValue := c.Query("start_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument start_date is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start_date", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", c.Request.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currency: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "proration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "proration", c.Request.URL.Query(), &params.Proration)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter proration: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", c.Request.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter service_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", c.Request.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", c.Request.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deleted: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserSummary(c, userId, params)
+}
+
+// CheckHealth operation middleware
+func (siw *ServerInterfaceWrapper) CheckHealth(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CheckHealth(c)
+}
 
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
@@ -43,13 +2342,186 @@ func RegisterHandlers(router gin.IRouter, si ServerInterface) {
 
 // RegisterHandlersWithOptions creates http.Handler with additional options
 func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
 
+	router.POST(options.BaseURL+"/api/v1/admin/subscriptions/purge", wrapper.PurgeSubscriptions)
+	router.GET(options.BaseURL+"/api/v1/exchange-rates", wrapper.ListExchangeRates)
+	router.DELETE(options.BaseURL+"/api/v1/exchange-rates/:currency/:month", wrapper.DeleteExchangeRate)
+	router.PUT(options.BaseURL+"/api/v1/exchange-rates/:currency/:month", wrapper.SetExchangeRate)
+	router.GET(options.BaseURL+"/api/v1/services", wrapper.ListServices)
+	router.POST(options.BaseURL+"/api/v1/services", wrapper.CreateService)
+	router.DELETE(options.BaseURL+"/api/v1/services/:name", wrapper.DeleteService)
+	router.GET(options.BaseURL+"/api/v1/services/:name", wrapper.GetService)
+	router.PATCH(options.BaseURL+"/api/v1/services/:name", wrapper.UpdateService)
+	router.GET(options.BaseURL+"/api/v1/subscriptions", wrapper.ListSubscriptions)
+	router.POST(options.BaseURL+"/api/v1/subscriptions", wrapper.CreateSubscription)
+	router.GET(options.BaseURL+"/api/v1/subscriptions/active", wrapper.ListActiveSubscriptions)
+	router.POST(options.BaseURL+"/api/v1/subscriptions/import", wrapper.ImportSubscriptions)
+	router.GET(options.BaseURL+"/api/v1/subscriptions/summary", wrapper.GetSubscriptionSummary)
+	router.DELETE(options.BaseURL+"/api/v1/subscriptions/:id", wrapper.DeleteSubscription)
+	router.GET(options.BaseURL+"/api/v1/subscriptions/:id", wrapper.GetSubscriptionByID)
+	router.PATCH(options.BaseURL+"/api/v1/subscriptions/:id", wrapper.UpdateSubscription)
+	router.PUT(options.BaseURL+"/api/v1/subscriptions/:id", wrapper.ReplaceSubscription)
+	router.GET(options.BaseURL+"/api/v1/subscriptions/:id/history", wrapper.GetSubscriptionHistory)
+	router.GET(options.BaseURL+"/api/v1/subscriptions/:id/prices", wrapper.GetSubscriptionPrices)
+	router.POST(options.BaseURL+"/api/v1/subscriptions/:id/restore", wrapper.RestoreSubscription)
+	router.POST(options.BaseURL+"/api/v1/subscriptions:batch", wrapper.BatchSubscriptions)
+	router.GET(options.BaseURL+"/api/v1/users", wrapper.ListUsers)
+	router.POST(options.BaseURL+"/api/v1/users", wrapper.CreateUser)
+	router.DELETE(options.BaseURL+"/api/v1/users/:user_id", wrapper.DeleteUser)
+	router.GET(options.BaseURL+"/api/v1/users/:user_id", wrapper.GetUser)
+	router.GET(options.BaseURL+"/api/v1/users/:user_id/subscriptions", wrapper.ListUserSubscriptions)
+	router.GET(options.BaseURL+"/api/v1/users/:user_id/summary", wrapper.GetUserSummary)
+	router.GET(options.BaseURL+"/health", wrapper.CheckHealth)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/6pWysxLy1eyyivNydFRyi9IzUssyFSyUlLSUSpILMkohsjUAgIAAP//GEmFXicAAAA=",
+	"H4sIAAAAAAAC/+x9D3MbN7LnV0HNvapNdocUJWudRFdXV45k7+oqTnyWnds8j06GZkAS6yHAABhJPIXf",
+	"/aobwPzFkENaljb7UpWqWBIGaDSARvev/+A+SuViKQUTRkcn99Gc0Ywp/OfLd3QG/8+YThVfGi5FdBKd",
+	"FkoxYcgNU5pLQeSUmDkjurguW8VEM5ERbsg1TT8RLsj5dPSamnROjCTFMqOGEU2nLF+NozjS6ZwtKIzE",
+	"7uhimbPoJEqiZ0kUxZFZLeFHbRQXs2i9XsfRkiq6YMYR+SI1/Ia9MF1CfxL5qkGWJqoQgosZkYKYOdck",
+	"o6uYSEWoIVSsiOELBtRS8vr16JdffvmFLKQwc6CRQ4+/FkytojgSdAFEURz6iprGHP5DsWl0Ev23g4qv",
+	"B/av+uCMGvaTeg2dRjCVENlnjqYGCXGHx5rcMsXKGXExJmdsSovcaGCykRld9RK+P8WnhdJSBXaFFIaL",
+	"ghE6NUwhtUs6Y8TMqSGKmUIJllmmC3ZnrlLsZ0xeF9qQawYbxpBbbuZ2onTBiJbKJAJ+tI3JLdWEa12w",
+	"jEylGieiZ3q2eWOK7W0UR2cs5wtuWGAurzjLM6IZbDQjVUz0UjGa6TljRhM5NUwQdreUylGcRP89ifp4",
+	"nZXj1OnJ7FJFJ1EcxdGC3v3AxMzMo5PDOFpwUfspQLlavS1El+yfac7xZLEbplZEyduYcJHmRQYbhM4o",
+	"F9ogezNq6DXVLEb6ZWHIJ8aW2EqszJyLWe9s1OpKFSI8lynNNSspvpYyZ1QgyS9FBhvplZKLLt0vqco5",
+	"04aAzIAJOLI1v2FjctHc845eKsrWRMB8yQLESx/ZTGRX0PZqChTsu/fdLN7J7hx+oOaRZmDk3vT/Tcli",
+	"+f0KPgsNMYM/X12vBvd/USwWVK18tzDE+RTFfJdBL5bLfIWbL51TMWNEgnTm3buDaMPznMypJlIwd7to",
+	"RuAy0mPylv1acMUyIkUibhU3TMeEEsV+LWABPHe5IVPKc8tucnz07ZhcALuT6M9JBPKRtumB34lVIty1",
+	"VpMu9kas+OTvsi0C5nz6oxSshx1vmV5KkVnynk2Od+fEBupg3GEkLkCIncq8WIgQjTQjlExRGsLBIZRk",
+	"fDpligmTrwgMmJEUv44J1bbliSUoJmw8GyfC/vl/LBVP2QluxXxFUqkNLOWSUQOinGg4ADS3PegNkt2S",
+	"Wp8VN2yBisCSGsMUfPR/P9DR/7u6/MvJ+C//0VUhyl9QpajbtSgl2RnLmWFZYPPmWrpLjGS2Uesuxmtu",
+	"Tm8YERLuMybIslAzlpEVM31n2gpnduW63FWoOqrfSUPz0IVcCENonlu5AsK9QfKYvIPpyOkUtr7+xJf2",
+	"NOBnUpCcqhkjOddGb6PfIAVB6o0qwsT/AJdiYM/JW1RfLLNjUMwWUhtyOJmQJVOoU/SRg/dsmIzDCV6y",
+	"fFEs4IcJXrLup5I8LgybMYXkvaZ3b2DLdin8O5/NmTagk6AajDsb5H3n/P5JE3krXMO0Vxlb0Lsr7KRB",
+	"eknfJEwfFz30/SBvH5Y8LnYn76fpVLMNywvbbUxOqbCnhaRycc0Fc/LQ6YY9BEnbd3CdJ/E2yt4wxWX2",
+	"UgROeV191oYqgxfutgt579vYUnIBA/XdyBUVURwpd/P5Q7XnqEoqaifc2dnyFiUz4ZpQYxS/LkDQGWm1",
+	"eST3hCSRkIIlEdybasY0/nFa5LndaiA6GE3n5JrnORezRKCGw4XmGSPcxCSJMsrzVRJ5tbrR3o3zJ+26",
+	"YzcMFAUJChI3aLFpQkUGN0shjHb67Er7Ia6lsyFsR9C2s/X7L5hlyZ4dlaCKr8Dlt9SwU3ewysVdUjNv",
+	"GCn2z/uubNm/H9AucsCaUdpYKxIZoWA9QP3hsHiy3N9N8rD93rQhKb8was/cBVM3PGU/Ys9BZuD/Ng3W",
+	"1V1qnb5RbMrvAlcg1WzEhWZCc7DUyRIbloiF7QH1mL5T7tpcwY9X9vNoMF3/G/vqO9u1nrd1CWJggP2E",
+	"4qJjf/TNrJQtn2cUlcRtMIv2JewzbJ0LQ02hN68ANtnhoFcCxPZuB6r9+vysZ3/zbOPunkq1oCY6iYoC",
+	"Wwa2QH1wqUxI31ss6MiBFqCfSgVm/UrHVr7azeuv2CQaJRGq3tALEwgRSIWKe84/sUQkkVUdRtV6JNGY",
+	"vAOpQRUj10p+YoJcNyE2cn7WL1yBpP3YDR9aNqCwrYvWEDiZrqwOiRANXGlwO8Qkq13wb99/P+6Hj7xk",
+	"3kMSv9dM9e6DQjN19dmbwQ6xcW9XA+3ecXVq2vYg2mtGWosCEVM5LW03GLLXVAjRU1puWwjrGGxrYB/Y",
+	"z5rh99/T7K21/+GnVArDBP4Tb7kUr+WDf2qr8wxb0pdKSfXWDWKHbDLjNc2BbJaV0INUCDmwjNxYIA4a",
+	"AmIqxTTn6SPS5phBUjeyQ0HYHdcGzjnAfxEakIYpQXPs8PHIey/Y3ZKlKKSYAsWOIQHrOPpRmleyENnj",
+	"EfOWaVmo1NrtUxwbtWSWSpFxaPQKF/UJKAK4eyEzPuXAKS5ShmoLwD9kxm+YqDtW2lS/LeXLY9HtKSEW",
+	"AAIrYsG1dkf4vVgqmTKt6XXOXgrDzeoxWYqgVcoylFIEESer/GWSaVx7PB0oBV23VrKYdP5aZqyJ2VMj",
+	"FzyN4ogJsDQ/VL9YUmU4zaPLgBTDzn5assr+Wiq5ZMpwK8b49GqxCTxFxPR2znPWhQrRZLPuFQdg2nsc",
+	"W7ZWBrxLiaiQ0DRlS+v/8thnhbKChmD9dWh1OfQLIFdBpJ9KDXVNRA12Xdb245W/8Kx6IAX7aRqdfNi8",
+	"sA5N/tmSFa3jQc1fiFW0vlzHkVwCK/0apYpZQ9rOJ4ojO5ngWtWZi5szzwfQ+x57rmsu/l5aX8YhJxN4",
+	"kwgllrQYFjedE8cpXXnCLCwKECu2REcNSIRZzhLRdLxKRRYMkLslrjh0L9wKAudbU4MrubPb6vTX/LWo",
+	"KwLD4I7frktUCs4HWIiKyfL6nyw15YGo3dzN47Bwx24Tw6vzicvt9qNuaBdbv6+O5BpRwnP7pYcJ/Y8B",
+	"3Lg5x3L0DXN1Mqoz2Wl5ybQxKxhFg9a626Te4kdd5QnWP00Zy8KjtSblh65/FXtiN00T5WR7kszrGVuF",
+	"+RkzlOfQIRcZC5j2b6RGseJt+UoWOaTTaWXjKA5w1Fl+XQTs3bs3xP6x1eutLPLMAvxz8Iro8gDWRyrD",
+	"F44mh8FxW1JlqP3TWRfLlXIiwZWweJpFGbtTfS+48czz0BtQqm5oboMNLP7GtQP6wOOWIqpWthvXbsBb",
+	"xj7lqyi2wBH+69eCKsMU/nvFKPwjJGpPUfg52KRXGqTUsJm0Zkmni7RmDw4z1GJ/mztsewukHUcZ18uc",
+	"rixcE6Khgeec3G/24sfRDROZVFeFysOYT321G12H1tqxMHDvdPjo1vrKr2F3Z/xYLK6Zgr3RhGQ1uWbm",
+	"ljHhkd+mQX0Iu2GTVyUux16We3LYtdrcyt2rtA7cu+03bm+LYSNVG6QziP+TPzTOsdLBFNZx5RrYBbOK",
+	"o3InlmLkeLLFodHdduW30S8UhAR5kyPGtWU71jwNuxHtDfvG0M8nzyeHU8ZGR9fTw9HxN0eHI8qeT0ff",
+	"PH/2nH3zHZ2k13RnJaIFmHqfVA1ZqObQf0gA4ug9HLXJNBf/b0wwC6vdzpkgcsGNYdkARcjSN0gmtKZb",
+	"fhecSy8Adn7xEzk+Ovym9OuRVGascTlFb99/H8UNf/mL0X9e3j9bB73l9QUPDvft88mhA3elIpTkbEbT",
+	"VTN+DW5VCzJOpXXkTNEtkdGVu0xh8RIBNoZvkdNaA4yWsbZDNZGjydFfR5NvRkeTd5PJCf73n82ZffVh",
+	"Mvru8v54Pfpq8uFw9N3lb4cfJqOjy6/Lnz8cHl1io9+efZgcXn79W7uh7+HrIHfq6kr30iotx4Z+bcAG",
+	"JgsKXnk2Uoxm+AtUj6rlchfrNc2unIoRxVEFLV1h8yiOhDRXFriII4/4wLkQn4S8BbvL2b54ZmoGmdPi",
+	"Wr8tt2Ac0WupbFiC4QsmC+g1pSJl9jPu0CNHSOhqR7sleFsumNZ0xrbffcjCqn3oMDTN/wfQOlsktOdX",
+	"G/nOBg+9pSYw8D5aCZtOmY0qnQZ9Pa/KU9PjyusejslhXUw507ezIMpNoRNSWGDkkRSMFDWVsZQuXHgo",
+	"vRz5u6PxX+sjyuI6r40pUL/oLnSFureY4IjbtgA/8JBIh0+Hm06NBV1vsfhs1yGyMCLuVAb1WPfb7k3+",
+	"iQXE+UXNRwnSFeGr8zPQO5beayOIj9wbh1ZWz6kKLO0rRdO6BYVBPOgsiUtFb4KYz+E4GrCcTetGDzAu",
+	"YcKxZUj7Y091iLl/ZzQ38y5nK6OuOgPyU4gjIM60oYtlw/kAJ2MEf9quiXjHYdVRiNAaHNVF9BB00w3U",
+	"rSb0/xwUpy04rHta7R+66ODCBVnTHnvYxgG+RX9Zl7EWIOxDJ3xE8Ml9J8wLuPZPBPoH7IYqsrgcr/Z9",
+	"kL+Wann7kHhDzkUoqIqXAZgQV+1BhinPmTWWHeZ9zeBEQh/21OwPPcAoG0AHixjuijlsh/tsv1YHc2TE",
+	"JGdTQwDflYJkakVUIfTuICBydiNkUUWNNA4xKnhHf20pdj1aWlBJc3Gnu0rkhdd5B4a6BERgn2ndaFgi",
+	"LG5blUknW46Mj9EJitAQgyswqHVQ9jNY9zQau9K0CnIrKQmSr3jK+ubwMNrWVj2pNNK3rE1Hh/H2aknn",
+	"lhmG1RnsZbg+U+fYNnXGdR0kC0KZ+6SsjXMewBLXMDSAU3M2w36VRNBGMbqAFYkjUeQ5mE+tWOMHBgeD",
+	"gJMHmywWNO4nZgOOuJX8oQDPFoSx+mxuzFKfHBws80KPV9jHWBXbObkZjtm4rd3yhre062j4pna9hXwb",
+	"xsfCb9mK5Zj+kzDVpm4P9KJG3nRqGUDszgW6vfYAnmVrV5MOIHw9RlKvIXTR8ix8MdC3oXIMRHl3wHb3",
+	"Pa6YQAGZmAELyljPRchn7j6MCb3GBERp5kzdct30cW6wDJpgbzv40ZnqwQzZWoLYjxA9DRqXXDIxYiJr",
+	"p5eMAxb8VsHRI7tO64kB6M7pun80YxXGrQkT2VJyYVN1GEabKiJFazMgWL0/QL0Fkg6BfkNwjUrf3jW2",
+	"M6g6D4J7h7a96TPkzkWq2IIJg143l89pJVGMmaeaoTngw4EG6IrtuTSA8zCyXkNkWmc67kqUuKnH+alt",
+	"k1Uvb1z0T9vkDKcovPBZVQy+w6xVywXEimGtWei0Aa+u2VSqBrLqbJ0yIiQrQ0IyND21kRYI7Sgu1RLS",
+	"1Ei1ATcDSv4xegGtykBBH4WTRHqlDVu4QFy7vposaMbA2sJsBhpwZNdGn7r85WHeraZL+bItQ0B2I5Me",
+	"skfHZCeaB4pUWNz2IeLCPD8OmtSOQ+6D7QrVzqe6rdp78uLAqXI71++MBnUlf/3KNdgz6KiEVSikaAcF",
+	"qt3r56hSbuyNilRtvPAEaiUBgjeWlsqfpqnMc3mLGgoFceh8cUSKShD4LMGNa78fvzayqlV/wlGGLsNG",
+	"riSoHpjXuaPg1lvZW+UG+FjFpnld3qKd4P4mvDL6n19Vf/oNG/9Wvya+/ire2uTrPwfBmMBdGzCwFL9h",
+	"LuvYVSxgmiiWU0zk8QUuTkixTCVYgk6+1xL38KYELtqSFInwmlpMbOEOgFucdjsmL6wCyIW7WrSgSz2X",
+	"xiUj+zvDD+dO+o1lKg4TvB9cwkJAJVeMfsrkrRi8C+sgVmATOv18iGpvmeny5apUuTBguY8+PuW5L88y",
+	"IHXulWu9jqsSBDtWHnBfDj/SlYcmwEo06zdyMqU5ExlVFgHQHrjbxMdh5lBlBy3riZq7JSC27qW+6VwU",
+	"C5iLmwImCdW/w/jz6qRsmx8Ktqs+MLUl1GqNG3qmY1JYyjU2Sx+M0B+gNVwzX/cPXyuh4UWCR2FbWrQf",
+	"boNUaOXieoktpGA1keN+xKTZYG8u6vjfLYCudfbYrXOCNpI3x9tDm7ZF2nWWuj+Ku0PV61aodcvGx4h9",
+	"f+2QBV2BEw4U1HEUt5YnBNHsGk33xXGWOtYxzEBoAP+XXdTibgT6G1sszao0GQZuKGx21YXw9yNsc860",
+	"YLc+vV1VsQ9V5EUzDrAKkHB9lAkVutvLeI/g0YeI1ttDBkLs3MaguZ2C4baHv1U4RW8gHJAUNil67Rfb",
+	"43BVAWe9zXliu+w3gtYYyj4NpEi/eHOO5n/DDUtnM8VmNvQc/vjS73LyWl5zyPpIxKnDC4xseROpYkSx",
+	"VKrMuhSpILTIuCG5nLkySB8dJvERvkaxejuXiDy4gmvYN0Z/fPzHyIm/0fkZtk+lQkXclnOoai+dn4Fd",
+	"MwuGStqSDSydS5aVRQENxunbuK0xTOnM5hkpV9EBE5g+Vnjfx9iPpQnNdZm6VPpMRytGFTBsQT46r/HH",
+	"cSJOc85sqQhqiM09rLf36nBJi7blCz/+YwQEjV7hrj6pffIxtpicK27hAwPc565Wnzcg/J/LNfC2YBYD",
+	"vwxwqSyQZ0G9RFjRg1Ws8hyIQ/784BI4YV3KTKDm4nPHGpAo0J8hHw/okh/cHB40Gh58tDlaNBFGUY52",
+	"gc6pdpUGIW25hHpTx78ClwnjXkzOBuzdGhB4Eh2OJy5LR9Alj06iZ+PJ+Jk1O6166gml2YKLFrmIweEp",
+	"l6GL+A1TCypsXSrFFvIGFrHBGF+3KZdihgUKqXAFj8SUzwqFmbyGCTcHOSYvaTpPBPZmTfbyUGEkRgOJ",
+	"DNTXIXOujVQry7IyleQ8A3Lh24tWyFMjp/loMnmw/Mi6+zaQHVmZNW5KDcbBmv11MukboyT6oJlNvEYr",
+	"xBm/dr7h0llRHBk605hFCeseXcKnfisw54AblXF8s2BdoVJwwCrchKIWaTtm0dVF8Jd4IQzP3WV/Z6qK",
+	"a9Q0SiQ11xEOZN1HqKNmedIP9w9eYeDyC26UTjhlYLf4Nhh8qm3NCJDpq5peJDIrLGHvHA/ZO7UM/ofY",
+	"bkA7YQ1Ca9ustac27LeDez+n9cE9zmht9x7sY/hXczfY+nF1JnZP9XGgfkudUH9I9mTd8eR4+ydllv1D",
+	"8NpOugpHbh411FStc5FQ4k3l3pXonJ4QXVWTg0adpXU8qH2l8y8L013Elte/QvC/l9nDpaz3xBasm3ql",
+	"M4ge5byHzvoFesCaJ+mpzjT48Xs2matNaXUmKTZtsNpRr8edzFhgJ4AUufCNdt2Ztr7hgC3pSuV9Uble",
+	"j78JLPMbOmO2koub7FOK7XpojlvF8lcoIKQOrFUjifQLndlgouqgE3v40CsZLnIx49rgZax9qz3vkO+2",
+	"f1JWtXmIhfekl4QH1z5wdg/uQaNqXcpttkwL7cteHU++cxU0IPC+oa2XeVK6ji/WK2g7P49NUvO6LIbB",
+	"BDR8ey3WN+Q2NeDCVySpKwA73uaPv3Tu+t+4cHFYvv6NmV7+TB7jxFzUj8lj601/Y2Yb13a7cepVFlG7",
+	"CdeSwVcRYIfbEkK+tIjyoE8GYKXA6Fpi7WgbftQ4LE0QPhFGljApoklwQq6LxVJjImdZkUZjM65sCesy",
+	"oglaW9taV9VKAC5C09oGnaVzUCGht8qsfiEIIsek7luIifd0AMpagf8kzRlVQFDouDZ8KF/o/gj6aR5Z",
+	"49twGix5n395/MuLLNze5eYlZegV7q4FMxTro229gtqhJP06ZAvu2O1YN2ryDdAnO/VHd/vG1VId8FFZ",
+	"gnpIW3o3uG29cueA5uX7L8O6rhVS3eWDd3JI8/ozF8ObD+u7VaN+HT+8CbK9pXv9ZQjnOuU7B0/RFrT/",
+	"sgZROyJtk1XURkOfzjTqwU2bv99qJNVaf1lLKVQH7ZHNpWYRpc4an7p0R93kSeANrNAortkBtlmv99sa",
+	"x0dH2z8JVU98iG1l59+eft++6rv9Dlwo3KZL0Irpz7sKhwn5x7gw/xCt/86itXxUjRpCbQaPCgPYg08I",
+	"X5S59UH/pT2IuhVBZJHN8jkvC3ueXvyMiefgstfM1dGBv1pnsksvALc3PpZjbTssLGofc3AW37V9wId8",
+	"VY89iX1ZK4+txqQZZhQnoh2pFBMXqxE3g29drNDXpBA505DXsFyWL3wgaWNiHf42MCoRUC5o9Pr16Oys",
+	"/v4dWIfWzEtZntvpYEZ6ITQzY/KyZA8UP3VvoGU2eKCqkmkD7bEzLEWkibTmpLwVY/IO3fewRNCJTS/1",
+	"gQpUkx/P/tfFTz+eoFOwVXUgEUuGIQQUSaCaaGkTTjj2lUlhAwIwDqNeaKF67c6+ZDEm/wdawS/sbkmE",
+	"NnKpyZJCY3gVsAxwxwoDHK3lRvUbwoU2jGZlmRbX8ztXrICgF5sChoV7DSeKTBHS1JhXPRYH7FiyFLsA",
+	"Z7gN9eCaXIMBZx1/Hgpg6k+eecSVDCKKmrl3t3NjR8cB0SWaCNfOV6kHHs6Ye9YDm5Q9yWm5XgED3nL2",
+	"8+4X97DeEHFafzhrQPvqsUEre/tULsPuzEGqb1rpzvUTag+oP3K14vyilk8XQzLekCpssQuTScTGNyg+",
+	"E6O4G4nM3yLVtO4TrAmRRCdHceIS9RJbxyJpZ9Yk0UkyqKxcEq0TcZ/Y2h9JdHKfYAkp/L5dPCuJ4sRX",
+	"lsIGyNuqWoogLmgMG6LcrJol0TouJ/CsPoHjycTS4AuZJBDFl/gKJ4l/0yspy5tAg3VrCQZWSG5Ko+0l",
+	"kmsCaGvjVl3ty8BN/sKKoroMdNKuEj94bTlWOKzPThtaP9m1bznRuvjxvj29+HmfK15XqR7BGJVTuVgW",
+	"xrkX8J2lTh2QKtr+Q+gqvcQoC8jzwIeVLJRavbqE92A9x6Oe4nHLRSZvx+T7Rg4IbWTMJAJqAoBekXeL",
+	"wsL9BBeWT9GopsG1fwwE8llwL/j8CBfIFtfBNhBdNrjKqlN/0mU3qRQ3TNUenXKSkmWVsxduTZx7K8AD",
+	"uGaDgW3Qjg28DdwU4Haoa9Bu2Xa9LOrPd63jgc1fimyYht985mRI/7X0j4Em0he1kKrUnJ2RrS9rndjV",
+	"DnmD7J/K0kfLMlT+ScIdGlWZUFrcMEWoJ2sPAXXPswHhSy14aLdD4Z9/Dazh8ZbKU48c93R8OAB2CTwD",
+	"gojNt7t9Wr7F8ZAO12GQzQbXa63l96vzsz3Wunrf9tEQhfDBfRjw7tnWPTqn9q0Q5ymtPcpinanY1yPD",
+	"h0/juB66+3Z0YTffUtvgxX7honYpAcu88eTFV29fnZJvnn33/Osyq7fm5HbIgZEF4CExBHNAYEfOU24w",
+	"Nauer/XJB4M0fN9lkZYNjuSHE6JD0Hmc/gin/5e9fNL7IvWPd8hL7/QTIvWPesfs7xV42vvJLtQOEqIw",
+	"wafmcpqWsSd4fsNllGiVYtQ6uNzUj2rnoLohHvmkPqof7fFOp2PmH8fzX/54upX6bJcfGBMHLhSrF/J4",
+	"Uaa8Bc+uzDOmvTZlHRljcmF4nhN6QzkmCtviHT7dapyIl+gKACOtQVDciMispdj5irauPghC6HKGxQ0+",
+	"saUZgBD83c3y9x0CHqwktME3Z9MVXXWf34tu2rSa7b6al8v3xdTUzaekqtkaPCQYGBXQM6HkM9r8hoNj",
+	"zk6SM18t2PpLqhR191yTB8EQIgMPWyjnDgunkRp+WEt/W6GabDMBBxwOS/0XzV5s1cQN7Vlfy5DLTJdy",
+	"BQXK73PrLusTerqd62rgwcQ+35AL+r3fC3yAkzrpbaFbb2ZfMyZ8TuqKYa55Dvh4u4pnmT1dCB/DHFD3",
+	"cCqd4KcnU5i0za36PSlMD6B84KzL5c4+Vw05ufbwQHh7vS2EJsUS3yebTKr3/NBbgKmrziG1lMporGUA",
+	"brQy5nxMzgWxj7vCU7yoPqwSgY9TYDSAUVRoW/PvxD0JoED0UJ4XihElIWIBwwjQkjFzn74PbbHabI2o",
+	"RFhCiHt5B0d3L8ra4VHmy+qtQ+3Sl7N6NENAaOOzjN1884e3WBpPej6ykdJ8YjN86GBx5dSZlSWPYlv9",
+	"wb/jndmCH08B+5+6l2Ddm6tVplG7zIMg10X+adiRKeud9EbnvccWv2sFt6wEs0GntYx4yjizsk6MWzX7",
+	"cz1kN5zUB7IJ2pJCZEzVIGd8ca2quXJ+NiYvbEu8Sm/9m8GQT8MXFufMV672R/MmTamAsAdV5RHautmS",
+	"aGZQuvhSPCERUz2v90WDiuvv9z1yMDHObXPiZaH3Fh1PmHVZ2EVr78m2BDm4d1FH++Rbwo7FbQnKXcty",
+	"r1IsGza83cBMsUSANlhXBF1IYUp1SjOMatPway7cE9op1d2C77pm2RCqE3HL8tzd/1Vhl3pZF1/YkatN",
+	"xVysieV2/pDqH5bqRrRPWf3PhgZ1nlIa5lAFGh7dkfpUKac9u7bf41nKpi94AwVdF58jFJ7Cu9fL2X0y",
+	"15pGZkuQ7JBFB719XnjnH5lxf2TG/ZG+8S+WvvH4Ei6Q74F5FY8q9dqhosHL6r9iaOIfsYa/OzeLC2V2",
+	"BmpfmOKDnKd5+fZrOMR6ziBhp1oxYj8g5YutLZsV2rv3ZL/gRnAj9FcgAQOmWLaYbL8iKdBY46NjgQ3G",
+	"t1k3lo9Y4Bpfdzs5OMhlSvO51Obk28m3E9znroN7b4a4jtZx+Zu2PK/+UBWGKn/nsZTyF61aW7W/2LqO",
+	"68v1/x8AzvVYkWymAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
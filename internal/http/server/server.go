@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"time"
@@ -15,6 +16,10 @@ type Config struct {
 	SSLMode      string        `yaml:"ssl_mode" env-default:"disable"`
 	ReadTimeout  time.Duration `yaml:"read_timeout"`
 	WriteTimeout time.Duration `yaml:"write_timeout"`
+	QueryTimeout time.Duration `yaml:"query_timeout" env-default:"5s"`
+	// StreamTimeout replaces the other timeouts on routes that stream their body, like CSV
+	// imports, whose queries are bounded by QueryTimeout one at a time
+	StreamTimeout time.Duration `yaml:"stream_timeout" env-default:"5m"`
 }
 
 func SetupConfig() (*Config, error) {
@@ -39,16 +44,24 @@ func SetupConfig() (*Config, error) {
 
 type Server struct {
 	httpServer *http.Server
+	cancel     context.CancelFunc
 }
 
 func New(scfg Config, handler http.Handler) *Server {
+	// Every request context derives from baseCtx, so Shutdown can cancel in-flight queries
+	baseCtx, cancel := context.WithCancel(context.Background())
+
 	srv := new(Server)
+	srv.cancel = cancel
 	srv.httpServer = &http.Server{
 		Addr:           scfg.Address,
 		Handler:        handler,
 		ReadTimeout:    scfg.ReadTimeout,
 		WriteTimeout:   scfg.WriteTimeout,
 		MaxHeaderBytes: 1 << 20, // 1 MB
+		BaseContext: func(net.Listener) context.Context {
+			return baseCtx
+		},
 	}
 	return srv
 }
@@ -58,5 +71,8 @@ func (s *Server) Run() error {
 }
 
 func (s *Server) Shutdown(ctx context.Context) error {
-	return s.httpServer.Shutdown(ctx)
+	err := s.httpServer.Shutdown(ctx)
+	// Requests still running after the grace period get their contexts cancelled
+	s.cancel()
+	return err
 }
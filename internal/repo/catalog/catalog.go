@@ -0,0 +1,267 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
+	"github.com/jmoiron/sqlx"
+)
+
+const serviceColumns = `service_name, display_name, category, default_price, currency, vendor_url`
+
+type CatalogStore struct {
+	storage *storage.Storage
+}
+
+func NewCatalogStorage(s *storage.Storage) *CatalogStore {
+	return &CatalogStore{storage: s}
+}
+
+func (s *CatalogStore) CreateService(ctx context.Context, name string, displayName, category *string, defaultPrice *int, currency string, vendorURL *string) (*models.Service, error) {
+	const op = "repo.catalog.CreateService"
+
+	query := `
+		INSERT INTO subscriptions.services (` + serviceColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + serviceColumns
+
+	svc, err := scanService(s.storage.DB.QueryRowContext(ctx, query, name, displayName, category, defaultPrice, currency, vendorURL))
+	if err != nil {
+		slog.Error("Failed to create service",
+			slog.String("operation", op),
+			slog.String("service_name", name),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to create service: %w", op, storage.MapError(err))
+	}
+
+	slog.Debug("Service created",
+		slog.String("operation", op),
+		slog.String("service_name", svc.Name))
+
+	return svc, nil
+}
+
+func (s *CatalogStore) Service(ctx context.Context, name string) (*models.Service, error) {
+	const op = "repo.catalog.Service"
+
+	query := `SELECT ` + serviceColumns + ` FROM subscriptions.services WHERE service_name = $1`
+
+	svc, err := scanService(s.storage.DB.QueryRowContext(ctx, query, name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, &models.NotFoundError{Entity: "service", ID: name})
+		}
+		slog.Error("Failed to get service",
+			slog.String("operation", op),
+			slog.String("service_name", name),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to get service: %w", op, storage.MapError(err))
+	}
+
+	return svc, nil
+}
+
+func (s *CatalogStore) GetAllServices(ctx context.Context, limit, offset int) ([]*models.Service, int, error) {
+	const op = "repo.catalog.GetAllServices"
+
+	var total int
+	err := s.storage.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM subscriptions.services`).Scan(&total)
+	if err != nil {
+		slog.Error("Failed to count services",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to count services: %w", op, storage.MapError(err))
+	}
+
+	query := `SELECT ` + serviceColumns + ` FROM subscriptions.services ORDER BY service_name LIMIT $1 OFFSET $2`
+
+	rows, err := s.storage.DB.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		slog.Error("Failed to query services",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to query services: %w", op, storage.MapError(err))
+	}
+	defer rows.Close()
+
+	services := []*models.Service{}
+	for rows.Next() {
+		svc, err := scanService(rows)
+		if err != nil {
+			slog.Error("Failed to scan service",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, 0, fmt.Errorf("%s: failed to scan service: %w", op, err)
+		}
+		services = append(services, svc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: failed to iterate services: %w", op, err)
+	}
+
+	slog.Debug("Fetched services",
+		slog.String("operation", op),
+		slog.Int("count", len(services)),
+		slog.Int("total_count", total))
+
+	return services, total, nil
+}
+
+func (s *CatalogStore) UpdateService(ctx context.Context, name string, newName, displayName, category *string, defaultPrice *int, currency, vendorURL *string) (*models.Service, error) {
+	const op = "repo.catalog.UpdateService"
+
+	query := "UPDATE subscriptions.services SET "
+	args := []interface{}{}
+	argCount := 0
+
+	set := func(column string, value interface{}) {
+		argCount++
+		query += fmt.Sprintf("%s = $%d, ", column, argCount)
+		args = append(args, value)
+	}
+
+	if newName != nil {
+		set("service_name", *newName)
+	}
+	if displayName != nil {
+		set("display_name", nullIfEmpty(*displayName))
+	}
+	if category != nil {
+		set("category", nullIfEmpty(*category))
+	}
+	if defaultPrice != nil {
+		set("default_price", *defaultPrice)
+	}
+	if currency != nil {
+		set("currency", *currency)
+	}
+	if vendorURL != nil {
+		set("vendor_url", nullIfEmpty(*vendorURL))
+	}
+
+	if len(args) == 0 {
+		svc, err := s.Service(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return svc, nil
+	}
+
+	query = query[:len(query)-2]
+	query += fmt.Sprintf(" WHERE service_name = $%d RETURNING %s", argCount+1, serviceColumns)
+	args = append(args, name)
+
+	var svc *models.Service
+	err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		// A rename leaves the subscriptions under the old name until the caller moves them in
+		// the same transaction, the reference is checked when it commits
+		if newName != nil && *newName != name {
+			_, err := tx.ExecContext(ctx, `SET CONSTRAINTS subscriptions.subscriptions_service_name_fkey DEFERRED`)
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		svc, err = scanService(tx.QueryRowContext(ctx, query, args...))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, &models.NotFoundError{Entity: "service", ID: name})
+		}
+		slog.Error("Failed to update service",
+			slog.String("operation", op),
+			slog.String("service_name", name),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to update service: %w", op, storage.MapError(err))
+	}
+
+	slog.Debug("Service updated",
+		slog.String("operation", op),
+		slog.String("service_name", name),
+		slog.String("new_service_name", svc.Name))
+
+	return svc, nil
+}
+
+func (s *CatalogStore) DeleteService(ctx context.Context, name string) error {
+	const op = "repo.catalog.DeleteService"
+
+	err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		// Lock the service so no subscription can be attached while we check
+		var locked string
+		err := tx.QueryRowContext(ctx,
+			`SELECT service_name FROM subscriptions.services WHERE service_name = $1 FOR UPDATE`,
+			name).Scan(&locked)
+		if errors.Is(err, sql.ErrNoRows) {
+			return &models.NotFoundError{Entity: "service", ID: name}
+		}
+		if err != nil {
+			return err
+		}
+
+		// Subscriptions keep their rows after they end or are deleted, so any of them, soft-deleted
+		// ones included, keeps the service in use
+		var referenced int
+		err = tx.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM subscriptions.subscriptions WHERE service_name = $1`,
+			name).Scan(&referenced)
+		if err != nil {
+			return err
+		}
+		if referenced > 0 {
+			return &models.ConflictError{
+				Message: fmt.Sprintf("service %q is referenced by %d subscriptions", name, referenced),
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM subscriptions.services WHERE service_name = $1`, name)
+		return err
+	})
+	if err != nil {
+		slog.Warn("Failed to delete service",
+			slog.String("operation", op),
+			slog.String("service_name", name),
+			slog.Any("error", err))
+		return fmt.Errorf("%s: failed to delete service: %w", op, storage.MapError(err))
+	}
+
+	slog.Debug("Service deleted",
+		slog.String("operation", op),
+		slog.String("service_name", name))
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanService(row rowScanner) (*models.Service, error) {
+	var svc models.Service
+	err := row.Scan(
+		&svc.Name,
+		&svc.DisplayName,
+		&svc.Category,
+		&svc.DefaultPrice,
+		&svc.Currency,
+		&svc.VendorURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &svc, nil
+}
+
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,120 @@
+package rates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
+)
+
+type RateStore struct {
+	storage *storage.Storage
+}
+
+func NewRateStorage(s *storage.Storage) *RateStore {
+	return &RateStore{storage: s}
+}
+
+// SetRate creates or replaces the rate of a currency starting at the given month.
+func (s *RateStore) SetRate(ctx context.Context, currency string, effectiveFrom models.Date, rate float64) (*models.ExchangeRate, error) {
+	const op = "repo.rates.SetRate"
+
+	query := `
+		INSERT INTO subscriptions.exchange_rates (currency, effective_from, rate)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (currency, effective_from) DO UPDATE SET rate = EXCLUDED.rate
+		RETURNING currency, effective_from, rate
+	`
+
+	var r models.ExchangeRate
+	err := s.storage.DB.QueryRowContext(ctx, query, currency, effectiveFrom, rate).Scan(&r.Currency, &r.EffectiveFrom, &r.Rate)
+	if err != nil {
+		slog.Error("Failed to set exchange rate",
+			slog.String("operation", op),
+			slog.String("currency", currency),
+			slog.String("effective_from", effectiveFrom.String()),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to set exchange rate: %w", op, storage.MapError(err))
+	}
+
+	slog.Debug("Exchange rate set",
+		slog.String("operation", op),
+		slog.String("currency", currency),
+		slog.String("effective_from", effectiveFrom.String()))
+
+	return &r, nil
+}
+
+// GetRates lists rates ordered by currency and month, optionally for one currency only.
+func (s *RateStore) GetRates(ctx context.Context, currency *string) ([]*models.ExchangeRate, error) {
+	const op = "repo.rates.GetRates"
+
+	query := `SELECT currency, effective_from, rate FROM subscriptions.exchange_rates`
+	args := []interface{}{}
+	if currency != nil {
+		query += ` WHERE currency = $1`
+		args = append(args, *currency)
+	}
+	query += ` ORDER BY currency, effective_from`
+
+	rows, err := s.storage.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.Error("Failed to query exchange rates",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to query exchange rates: %w", op, storage.MapError(err))
+	}
+	defer rows.Close()
+
+	rates := []*models.ExchangeRate{}
+	for rows.Next() {
+		var r models.ExchangeRate
+		if err := rows.Scan(&r.Currency, &r.EffectiveFrom, &r.Rate); err != nil {
+			slog.Error("Failed to scan exchange rate",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, fmt.Errorf("%s: failed to scan exchange rate: %w", op, err)
+		}
+		rates = append(rates, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: failed to iterate exchange rates: %w", op, err)
+	}
+
+	return rates, nil
+}
+
+func (s *RateStore) DeleteRate(ctx context.Context, currency string, effectiveFrom models.Date) error {
+	const op = "repo.rates.DeleteRate"
+
+	result, err := s.storage.DB.ExecContext(ctx,
+		`DELETE FROM subscriptions.exchange_rates WHERE currency = $1 AND effective_from = $2`,
+		currency, effectiveFrom)
+	if err != nil {
+		slog.Error("Failed to delete exchange rate",
+			slog.String("operation", op),
+			slog.String("currency", currency),
+			slog.Any("error", err))
+		return fmt.Errorf("%s: failed to delete exchange rate: %w", op, storage.MapError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: failed to get affected rows: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, &models.NotFoundError{
+			Entity: "exchange rate",
+			ID:     fmt.Sprintf("%s %s", currency, effectiveFrom.Format(models.MonthYearLayout)),
+		})
+	}
+
+	slog.Debug("Exchange rate deleted",
+		slog.String("operation", op),
+		slog.String("currency", currency),
+		slog.String("effective_from", effectiveFrom.String()))
+
+	return nil
+}
@@ -1,26 +1,67 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/repo/catalog"
+	"github.com/DenHax/subscription-manager/internal/repo/rates"
 	"github.com/DenHax/subscription-manager/internal/repo/subscription"
+	"github.com/DenHax/subscription-manager/internal/repo/user"
 	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
 )
 
 type Subscriptions interface {
-	CreateSubscrition(serviceName string, price int, userID string, startDate string, endDate *string) (*models.Subscription, error)
-	Subscription(id string) (*models.Subscription, error)
-	DeleteSubscription(id string) error
-	GetAllSubscriptions(userID *string, serviceName *string, limit, offset int) ([]*models.Subscription, int, error)
-	UpdateSubscription(id, serviceName *string, price *int, userID *string, startDate *string, endDate *string) (*models.Subscription, error)
-	SummarySubscription(startDate, endDate string, userID *string, serviceName *string) (int, error)
+	CreateSubscrition(ctx context.Context, serviceName string, price int, currency, billingPeriod string, billingInterval int, userID string, startDate string, endDate *string) (*models.Subscription, error)
+	Subscription(ctx context.Context, id string) (*models.Subscription, error)
+	DeleteSubscription(ctx context.Context, id string, versions []int) error
+	RestoreSubscription(ctx context.Context, id string) (*models.Subscription, error)
+	PurgeSubscriptions(ctx context.Context) (int, error)
+	PurgeUserSubscriptions(ctx context.Context, userID string) (int, error)
+	RenameService(ctx context.Context, name, newName string) (int, error)
+	SubscriptionHistory(ctx context.Context, id string, limit, offset int) ([]*models.SubscriptionEvent, int, error)
+	SubscriptionPrices(ctx context.Context, id string) ([]*models.PricePeriod, error)
+	GetAllSubscriptions(ctx context.Context, filter models.SubscriptionFilter, page models.PageRequest) ([]*models.Subscription, models.PageInfo, error)
+	UpdateSubscription(ctx context.Context, id, serviceName *string, price *int, currency *string, priceFrom *string, billingPeriod *string, billingInterval *int, userID *string, startDate *string, endDate *string, versions []int) (*models.Subscription, error)
+	SummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) (*models.Summary, error)
+	MonthlySummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.MonthlyCost, int, error)
+	GroupedSummarySubscription(ctx context.Context, startDate, endDate string, groupBy, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.GroupCost, int, error)
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Services interface {
+	CreateService(ctx context.Context, name string, displayName, category *string, defaultPrice *int, currency string, vendorURL *string) (*models.Service, error)
+	Service(ctx context.Context, name string) (*models.Service, error)
+	GetAllServices(ctx context.Context, limit, offset int) ([]*models.Service, int, error)
+	UpdateService(ctx context.Context, name string, newName, displayName, category *string, defaultPrice *int, currency, vendorURL *string) (*models.Service, error)
+	DeleteService(ctx context.Context, name string) error
+}
+
+type Users interface {
+	CreateUser(ctx context.Context, userID *string, username string) (*models.User, error)
+	User(ctx context.Context, id string) (*models.User, error)
+	GetAllUsers(ctx context.Context, limit, offset int) ([]*models.User, int, error)
+	DeleteUser(ctx context.Context, id string) error
+}
+
+type Rates interface {
+	SetRate(ctx context.Context, currency string, effectiveFrom models.Date, rate float64) (*models.ExchangeRate, error)
+	GetRates(ctx context.Context, currency *string) ([]*models.ExchangeRate, error)
+	DeleteRate(ctx context.Context, currency string, effectiveFrom models.Date) error
 }
 
 type Repository struct {
 	Subscriptions
+	Services
+	Users
+	Rates
 }
 
 func NewRepository(s *storage.Storage) *Repository {
 	return &Repository{
 		Subscriptions: subscription.NewSubStorage(s),
+		Services:      catalog.NewCatalogStorage(s),
+		Users:         user.NewUserStorage(s),
+		Rates:         rates.NewRateStorage(s),
 	}
 }
@@ -0,0 +1,164 @@
+package subscription
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+)
+
+// subscriptionSorts maps the sort keys onto the columns they order by.
+var subscriptionSorts = map[string]string{
+	models.SortStartDate:   "start_date",
+	models.SortPrice:       "price",
+	models.SortServiceName: "service_name",
+}
+
+// sortKey is one column of a sort.
+type sortKey struct {
+	column string
+	desc   bool
+}
+
+// cursor points just past the last row of a page. It is handed out base64 encoded and only
+// valid with the sort it was issued for.
+type cursor struct {
+	Sort string            `json:"s"`
+	Keys []json.RawMessage `json:"k"`
+	ID   string            `json:"id"`
+}
+
+// parseSort resolves a sort such as "price,-start_date" into its columns and directions.
+func parseSort(sort string) ([]sortKey, error) {
+	var keys []sortKey
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(sort, ",") {
+		column, ok := subscriptionSorts[strings.TrimPrefix(part, "-")]
+		if !ok {
+			return nil, &models.ValidationError{Field: "sort", Message: fmt.Sprintf("unsupported sort key %q", part)}
+		}
+		if seen[column] {
+			return nil, &models.ValidationError{Field: "sort", Message: fmt.Sprintf("sort key %q is repeated", column)}
+		}
+		seen[column] = true
+		keys = append(keys, sortKey{column: column, desc: strings.HasPrefix(part, "-")})
+	}
+	return keys, nil
+}
+
+// orderBy returns the ORDER BY list for keys, the subscription ID follows the last key's
+// direction.
+func orderBy(keys []sortKey) string {
+	parts := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		parts = append(parts, key.column+direction(key.desc))
+	}
+	parts = append(parts, "subscription_id"+direction(keys[len(keys)-1].desc))
+	return strings.Join(parts, ", ")
+}
+
+func direction(desc bool) string {
+	if desc {
+		return " DESC"
+	}
+	return " ASC"
+}
+
+// addAfter restricts w to the rows following the given position in the order of keys. Keys may
+// run in different directions, so this is spelled out as (a > x) OR (a = x AND b < y) ...
+// rather than a row comparison.
+func addAfter(w *where, keys []sortKey, values []interface{}, id string) {
+	var (
+		groups []string
+		args   []interface{}
+	)
+	for i := 0; i <= len(keys); i++ {
+		var parts []string
+		for j := 0; j < i; j++ {
+			parts = append(parts, keys[j].column+" = ?")
+			args = append(args, values[j])
+		}
+		if i < len(keys) {
+			parts = append(parts, keys[i].column+after(keys[i].desc)+"?")
+			args = append(args, values[i])
+		} else {
+			parts = append(parts, "subscription_id"+after(keys[i-1].desc)+"?::uuid")
+			args = append(args, id)
+		}
+		groups = append(groups, "("+strings.Join(parts, " AND ")+")")
+	}
+	w.add("("+strings.Join(groups, " OR ")+")", args...)
+}
+
+func after(desc bool) string {
+	if desc {
+		return " < "
+	}
+	return " > "
+}
+
+// encodeCursor builds the cursor following sub in the given sort.
+func encodeCursor(sort string, keys []sortKey, sub *models.Subscription) (string, error) {
+	c := cursor{Sort: sort, ID: sub.Id}
+	for _, key := range keys {
+		var value interface{}
+		switch key.column {
+		case "price":
+			value = sub.Price
+		case "service_name":
+			value = sub.ServiceName
+		default:
+			value = sub.StartDate.Time
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		c.Keys = append(c.Keys, raw)
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor returns the sort values and subscription ID a cursor points past, checking that
+// it was issued for sort. The values are pointers to the column types, ready to be used as query
+// arguments.
+func decodeCursor(value, sort string, keys []sortKey) ([]interface{}, string, error) {
+	invalid := &models.ValidationError{Field: "cursor", Message: "invalid cursor"}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, "", invalid
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, "", invalid
+	}
+	if c.Sort != sort || len(c.Keys) != len(keys) {
+		return nil, "", &models.ValidationError{Field: "cursor", Message: "cursor was issued for a different sort"}
+	}
+
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		switch key.column {
+		case "price":
+			values[i] = new(int)
+		case "service_name":
+			values[i] = new(string)
+		default:
+			values[i] = new(time.Time)
+		}
+		if err := json.Unmarshal(c.Keys[i], values[i]); err != nil {
+			return nil, "", invalid
+		}
+	}
+	return values, c.ID, nil
+}
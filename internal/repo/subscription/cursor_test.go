@@ -0,0 +1,198 @@
+package subscription
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		sort    string
+		want    []sortKey
+		wantErr bool
+	}{
+		{sort: "start_date", want: []sortKey{{column: "start_date"}}},
+		{sort: "-price", want: []sortKey{{column: "price", desc: true}}},
+		{
+			sort: "price,-start_date,service_name",
+			want: []sortKey{{column: "price"}, {column: "start_date", desc: true}, {column: "service_name"}},
+		},
+		{sort: "", wantErr: true},
+		{sort: "cost", wantErr: true},
+		{sort: "price,", wantErr: true},
+		{sort: "price,-price", wantErr: true},
+		{sort: "--price", wantErr: true},
+		{sort: "price ,start_date", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			got, err := parseSort(tt.sort)
+			if tt.wantErr {
+				var validation *models.ValidationError
+				if !errors.As(err, &validation) || validation.Field != "sort" {
+					t.Fatalf("parseSort(%q) error = %v, want a sort ValidationError", tt.sort, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSort(%q) error: %v", tt.sort, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSort(%q) = %+v, want %+v", tt.sort, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	tests := []struct {
+		sort string
+		want string
+	}{
+		{sort: "start_date", want: "start_date ASC, subscription_id ASC"},
+		{sort: "-price", want: "price DESC, subscription_id DESC"},
+		{sort: "price,-start_date", want: "price ASC, start_date DESC, subscription_id DESC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			keys, err := parseSort(tt.sort)
+			if err != nil {
+				t.Fatalf("parseSort(%q) error: %v", tt.sort, err)
+			}
+			if got := orderBy(keys); got != tt.want {
+				t.Errorf("orderBy(%q) = %q, want %q", tt.sort, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddAfter(t *testing.T) {
+	keys, err := parseSort("price,-start_date")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC)
+
+	w := &where{}
+	w.add("user_id = ?::uuid", "60601fee-2bf1-4721-ae6f-7636e79a0cba")
+	addAfter(w, keys, []interface{}{400, start}, "70601fee-2bf1-4721-ae6f-7636e79a0cba")
+
+	wantSQL := "user_id = $1::uuid AND ((price > $2) OR (price = $3 AND start_date < $4) OR " +
+		"(price = $5 AND start_date = $6 AND subscription_id < $7::uuid))"
+	if got := w.sql(); got != wantSQL {
+		t.Errorf("sql = %q, want %q", got, wantSQL)
+	}
+
+	wantArgs := []interface{}{
+		"60601fee-2bf1-4721-ae6f-7636e79a0cba",
+		400, 400, start, 400, start,
+		"70601fee-2bf1-4721-ae6f-7636e79a0cba",
+	}
+	if !reflect.DeepEqual(w.args, wantArgs) {
+		t.Errorf("args = %v, want %v", w.args, wantArgs)
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	start := time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC)
+	sub := &models.Subscription{
+		Id:          "60601fee-2bf1-4721-ae6f-7636e79a0cba",
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		StartDate:   models.Date{Time: start},
+	}
+
+	tests := []struct {
+		sort string
+		want []interface{}
+	}{
+		{sort: "start_date", want: []interface{}{start}},
+		{sort: "-price", want: []interface{}{400}},
+		{sort: "service_name,-price,start_date", want: []interface{}{"Yandex Plus", 400, start}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			keys, err := parseSort(tt.sort)
+			if err != nil {
+				t.Fatalf("parseSort(%q) error: %v", tt.sort, err)
+			}
+
+			encoded, err := encodeCursor(tt.sort, keys, sub)
+			if err != nil {
+				t.Fatalf("encodeCursor error: %v", err)
+			}
+
+			values, id, err := decodeCursor(encoded, tt.sort, keys)
+			if err != nil {
+				t.Fatalf("decodeCursor error: %v", err)
+			}
+			if id != sub.Id {
+				t.Errorf("id = %q, want %q", id, sub.Id)
+			}
+			if len(values) != len(tt.want) {
+				t.Fatalf("got %d values, want %d", len(values), len(tt.want))
+			}
+			for i, value := range values {
+				// Values come back as pointers, ready to be bound as query arguments
+				got := reflect.ValueOf(value).Elem().Interface()
+				if want, ok := tt.want[i].(time.Time); ok {
+					if !got.(time.Time).Equal(want) {
+						t.Errorf("value %d = %v, want %v", i, got, want)
+					}
+					continue
+				}
+				if got != tt.want[i] {
+					t.Errorf("value %d = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejects(t *testing.T) {
+	sub := &models.Subscription{Id: "60601fee-2bf1-4721-ae6f-7636e79a0cba", Price: 400}
+	priceKeys, _ := parseSort("price")
+	valid, err := encodeCursor("price", priceKeys, sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   string
+	}{
+		{name: "not base64", cursor: "not a cursor!", sort: "price"},
+		{name: "not json", cursor: encode("price=400"), sort: "price"},
+		{name: "missing id", cursor: encode(`{"s":"price","k":[400]}`), sort: "price"},
+		{name: "wrong value type", cursor: encode(`{"s":"price","k":["400"],"id":"x"}`), sort: "price"},
+		{name: "issued for another sort", cursor: valid, sort: "-price"},
+		{name: "issued for fewer keys", cursor: valid, sort: "price,start_date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := parseSort(tt.sort)
+			if err != nil {
+				t.Fatalf("parseSort(%q) error: %v", tt.sort, err)
+			}
+
+			_, _, err = decodeCursor(tt.cursor, tt.sort, keys)
+			var validation *models.ValidationError
+			if !errors.As(err, &validation) || validation.Field != "cursor" {
+				t.Errorf("decodeCursor error = %v, want a cursor ValidationError", err)
+			}
+		})
+	}
+}
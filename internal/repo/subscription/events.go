@@ -0,0 +1,167 @@
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
+	"github.com/jmoiron/sqlx"
+)
+
+// systemActor is recorded when a change is made without request metadata, e.g. by a job.
+const systemActor = "system"
+
+// recordEvent appends an entry to the audit log inside the transaction making the change, so
+// the log cannot diverge from the data.
+func recordEvent(ctx context.Context, tx *sqlx.Tx, action, subscriptionID string, before, after *models.Subscription) error {
+	meta := models.RequestMetaFrom(ctx)
+
+	actor := meta.Actor
+	if actor == "" {
+		actor = systemActor
+	}
+
+	var requestID *string
+	if meta.RequestID != "" {
+		requestID = &meta.RequestID
+	}
+
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO subscriptions.subscription_events (subscription_id, action, actor, request_id, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, subscriptionID, action, actor, requestID, beforeJSON, afterJSON)
+	if err != nil {
+		return fmt.Errorf("failed to record %s event: %w", action, err)
+	}
+
+	return nil
+}
+
+// snapshot encodes a subscription for a JSONB column, nil stays NULL.
+func snapshot(sub *models.Subscription) (interface{}, error) {
+	if sub == nil {
+		return nil, nil
+	}
+
+	// The status depends on the day it is read, so it is left out
+	stored := *sub
+	stored.Status = ""
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode subscription snapshot: %w", err)
+	}
+	return string(data), nil
+}
+
+// SubscriptionHistory returns the audit log of a subscription, oldest first. It stays readable
+// after the subscription itself was purged, and is empty for subscriptions older than the log.
+func (s *SubStore) SubscriptionHistory(ctx context.Context, id string, limit, offset int) ([]*models.SubscriptionEvent, int, error) {
+	const op = "repo.subscription.SubscriptionHistory"
+
+	var total int
+	err := s.storage.DB.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM subscriptions.subscription_events WHERE subscription_id = $1`,
+		id).Scan(&total)
+	if err != nil {
+		slog.Error("Failed to count subscription events",
+			slog.String("operation", op),
+			slog.String("subscription_id", id),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to count events: %w", op, storage.MapError(err))
+	}
+	if total == 0 {
+		// Subscriptions created before the audit log existed have no events yet, they only
+		// count as unknown when there is no row for them either
+		exists, err := s.subscriptionExists(ctx, id)
+		if err != nil {
+			slog.Error("Failed to check subscription",
+				slog.String("operation", op),
+				slog.String("subscription_id", id),
+				slog.Any("error", err))
+			return nil, 0, fmt.Errorf("%s: failed to check subscription: %w", op, storage.MapError(err))
+		}
+		if !exists {
+			return nil, 0, fmt.Errorf("%s: %w", op, &models.NotFoundError{Entity: "subscription", ID: id})
+		}
+		return []*models.SubscriptionEvent{}, 0, nil
+	}
+
+	rows, err := s.storage.DB.QueryContext(ctx, `
+		SELECT event_id, subscription_id, action, actor, request_id, before, after, created_at
+		FROM subscriptions.subscription_events
+		WHERE subscription_id = $1
+		ORDER BY event_id
+		LIMIT $2 OFFSET $3
+	`, id, limit, offset)
+	if err != nil {
+		slog.Error("Failed to query subscription events",
+			slog.String("operation", op),
+			slog.String("subscription_id", id),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to query events: %w", op, storage.MapError(err))
+	}
+	defer rows.Close()
+
+	events := []*models.SubscriptionEvent{}
+	for rows.Next() {
+		var (
+			event         models.SubscriptionEvent
+			before, after []byte
+		)
+		err := rows.Scan(&event.Id, &event.SubscriptionId, &event.Action, &event.Actor, &event.RequestId, &before, &after, &event.CreatedAt)
+		if err != nil {
+			slog.Error("Failed to scan subscription event",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, 0, fmt.Errorf("%s: failed to scan event: %w", op, err)
+		}
+		if event.Before, err = parseSnapshot(before); err != nil {
+			return nil, 0, fmt.Errorf("%s: failed to decode event %d: %w", op, event.Id, err)
+		}
+		if event.After, err = parseSnapshot(after); err != nil {
+			return nil, 0, fmt.Errorf("%s: failed to decode event %d: %w", op, event.Id, err)
+		}
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: failed to iterate events: %w", op, err)
+	}
+
+	return events, total, nil
+}
+
+// parseSnapshot decodes a nullable JSONB snapshot, so its dates are written like those of any
+// other subscription.
+func parseSnapshot(data []byte) (*models.Subscription, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var sub models.Subscription
+	if err := json.Unmarshal(data, &sub); err != nil {
+		return nil, fmt.Errorf("failed to decode subscription snapshot: %w", err)
+	}
+	return &sub, nil
+}
+
+// subscriptionExists reports whether there is a row for the subscription, soft-deleted or not.
+func (s *SubStore) subscriptionExists(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	err := s.storage.DB.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM subscriptions.subscriptions WHERE subscription_id = $1)`,
+		id).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,101 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
+	"github.com/jmoiron/sqlx"
+)
+
+// latestPriceQuery selects the price and currency of the most recent period of the subscription
+// being updated, subscriptions.price and subscriptions.currency always mirror it.
+const latestPriceQuery = `
+	SELECT p.price, p.currency FROM subscriptions.subscription_prices p
+	WHERE p.subscription_id = subscriptions.subscription_id
+	ORDER BY p.effective_from DESC
+	LIMIT 1`
+
+// setPrice starts a price period at the given date. Setting a price twice for the same date
+// overwrites it.
+func setPrice(ctx context.Context, tx *sqlx.Tx, id string, effectiveFrom models.Date, price int, currency string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO subscriptions.subscription_prices (subscription_id, effective_from, price, currency)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (subscription_id, effective_from) DO UPDATE SET price = EXCLUDED.price, currency = EXCLUDED.currency
+	`, id, effectiveFrom, price, currency)
+	if err != nil {
+		return fmt.Errorf("failed to set price period: %w", err)
+	}
+	return nil
+}
+
+// priceEffectiveFrom picks the date a price change applies from: the requested one, else the
+// first day of the current month, but never before the subscription starts.
+func priceEffectiveFrom(requested *models.Date, start models.Date) models.Date {
+	var from models.Date
+	if requested != nil {
+		from = *requested
+	} else {
+		now := time.Now().UTC()
+		from = models.NewDate(time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	if from.Before(start.Time) {
+		return start
+	}
+	return from
+}
+
+// SubscriptionPrices returns the price periods of a subscription, oldest first. They stay
+// readable while the subscription is deleted, as it can still be restored until it is purged.
+func (s *SubStore) SubscriptionPrices(ctx context.Context, id string) ([]*models.PricePeriod, error) {
+	const op = "repo.subscription.SubscriptionPrices"
+
+	// Check the subscription first, so a missing one is reported instead of an empty list
+	exists, err := s.subscriptionExists(ctx, id)
+	if err != nil {
+		slog.Error("Failed to check subscription",
+			slog.String("operation", op),
+			slog.String("subscription_id", id),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to check subscription: %w", op, storage.MapError(err))
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s: %w", op, &models.NotFoundError{Entity: "subscription", ID: id})
+	}
+
+	rows, err := s.storage.DB.QueryContext(ctx, `
+		SELECT effective_from, price, currency FROM subscriptions.subscription_prices
+		WHERE subscription_id = $1
+		ORDER BY effective_from
+	`, id)
+	if err != nil {
+		slog.Error("Failed to query subscription prices",
+			slog.String("operation", op),
+			slog.String("subscription_id", id),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to query prices: %w", op, storage.MapError(err))
+	}
+	defer rows.Close()
+
+	prices := []*models.PricePeriod{}
+	for rows.Next() {
+		var period models.PricePeriod
+		if err := rows.Scan(&period.EffectiveFrom, &period.Price, &period.Currency); err != nil {
+			slog.Error("Failed to scan subscription price",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, fmt.Errorf("%s: failed to scan price: %w", op, err)
+		}
+		prices = append(prices, &period)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: failed to iterate prices: %w", op, err)
+	}
+
+	return prices, nil
+}
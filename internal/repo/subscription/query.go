@@ -0,0 +1,59 @@
+package subscription
+
+import (
+	"fmt"
+	"strings"
+)
+
+// where collects AND-ed query conditions together with their arguments, so values always reach
+// the database as bound parameters and never as SQL text.
+type where struct {
+	conds []string
+	args  []interface{}
+}
+
+// add appends a condition, binding each "?" in it to the next of args. Conditions must not
+// contain any other question mark.
+func (w *where) add(cond string, args ...interface{}) {
+	if strings.Count(cond, "?") != len(args) {
+		panic(fmt.Sprintf("where: %d arguments for %q", len(args), cond))
+	}
+
+	var b strings.Builder
+	for _, arg := range args {
+		i := strings.IndexByte(cond, '?')
+		b.WriteString(cond[:i])
+		b.WriteString(w.bind(arg))
+		cond = cond[i+1:]
+	}
+	b.WriteString(cond)
+	w.conds = append(w.conds, b.String())
+}
+
+// bind adds a single argument and returns its placeholder, for parts of a query outside the
+// WHERE clause such as LIMIT.
+func (w *where) bind(arg interface{}) string {
+	w.args = append(w.args, arg)
+	return fmt.Sprintf("$%d", len(w.args))
+}
+
+// sql returns the conditions as the body of a WHERE clause.
+func (w *where) sql() string {
+	if len(w.conds) == 0 {
+		return "true"
+	}
+	return strings.Join(w.conds, " AND ")
+}
+
+// clone returns a copy that can be extended without affecting w.
+func (w *where) clone() *where {
+	return &where{
+		conds: append([]string(nil), w.conds...),
+		args:  append([]interface{}(nil), w.args...),
+	}
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern so value matches literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -1,13 +1,19 @@
 package subscription
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/DenHax/subscription-manager/internal/domain/models"
 	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type SubStore struct {
@@ -18,216 +24,550 @@ func NewSubStorage(s *storage.Storage) *SubStore {
 	return &SubStore{storage: s}
 }
 
-func (s *SubStore) CreateSubscrition(serviceName string, price int, userID string, startDate string, endDate *string) (*models.Subscription, error) {
+const subscriptionColumns = `subscription_id, user_id, service_name, price, currency, billing_period, billing_interval, start_date, end_date, version, deleted_at, ` + statusColumn
+
+// statusColumn derives the status from the dates, in line with statusConditions. Dates are
+// stored as midnight UTC, so they are compared with the current day in UTC whatever the session
+// time zone is.
+const statusColumn = `CASE
+	WHEN ` + startDay + ` > ` + today + ` THEN 'upcoming'
+	WHEN ` + endDay + ` < ` + today + ` THEN 'expired'
+	ELSE 'active' END`
+
+const (
+	today    = `(now() AT TIME ZONE 'UTC')::date`
+	startDay = `(start_date AT TIME ZONE 'UTC')::date`
+	endDay   = `(end_date AT TIME ZONE 'UTC')::date`
+)
+
+// statusConditions select the subscriptions with a given status.
+var statusConditions = map[string]string{
+	models.StatusUpcoming: startDay + " > " + today,
+	models.StatusActive:   startDay + " <= " + today + " AND (end_date IS NULL OR " + endDay + " >= " + today + ")",
+	models.StatusExpired:  endDay + " < " + today,
+}
+
+func (s *SubStore) CreateSubscrition(ctx context.Context, serviceName string, price int, currency, billingPeriod string, billingInterval int, userID string, startDate string, endDate *string) (*models.Subscription, error) {
 	const op = "repo.subscription.CreateSubscrition"
 
-	// Parse start date from format like "07-2025" to time.Time
-	startTime, err := time.Parse("01-2006", startDate)
+	// Parse start date from format like "2025-07-20" or "07-2025" to time.Time
+	startTime, err := parseDate("start_date", startDate)
 	if err != nil {
-		return nil, fmt.Errorf("%s: invalid start date format: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var endTime *time.Time
+	var endTime *models.Date
 	if endDate != nil {
-		parsedEndDate, err := time.Parse("01-2006", *endDate)
+		parsedEndDate, err := parseEndDate("end_date", *endDate)
 		if err != nil {
-			return nil, fmt.Errorf("%s: invalid end date format: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		endTime = &parsedEndDate
 	}
 
 	query := `
-		INSERT INTO subscriptions.subscriptions (user_id, service_name, price, start_date, end_date)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING subscription_id, user_id, service_name, price, start_date, end_date
-	`
+		INSERT INTO subscriptions.subscriptions (user_id, service_name, price, currency, billing_period, billing_interval, start_date, end_date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + subscriptionColumns
 
-	var sub models.Subscription
-	err = s.storage.DB.QueryRow(query, userID, serviceName, price, startTime, endTime).Scan(
-		&sub.Id,
-		&sub.UserId,
-		&sub.ServiceName,
-		&sub.Price,
-		&sub.StartDate,
-		&sub.EndDate,
-	)
+	var sub *models.Subscription
+	err = s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		if err := s.ensureReferences(ctx, tx, &userID, &serviceName); err != nil {
+			return err
+		}
+
+		var err error
+		sub, err = scanSubscription(tx.QueryRowContext(ctx, query, userID, serviceName, price, currency, billingPeriod, billingInterval, startTime, endTime))
+		if err != nil {
+			return err
+		}
+
+		if err := setPrice(ctx, tx, sub.Id, startTime, price, currency); err != nil {
+			return err
+		}
+
+		return recordEvent(ctx, tx, models.EventCreated, sub.Id, nil, sub)
+	})
 	if err != nil {
 		slog.Error("Failed to create subscription",
 			slog.String("operation", op),
 			slog.String("user_id", userID),
 			slog.String("service_name", serviceName),
 			slog.Int("price", price),
+			slog.String("currency", currency),
 			slog.Any("error", err))
-		return nil, fmt.Errorf("%s: failed to create subscription: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to create subscription: %w", op, storage.MapError(err))
 	}
 
 	slog.Debug("Subscription created",
 		slog.String("operation", op),
-		slog.Int("subscription_id", sub.Id))
+		slog.String("subscription_id", sub.Id))
 
-	return &sub, nil
+	return sub, nil
 }
 
-func (s *SubStore) Subscription(id string) (*models.Subscription, error) {
+func (s *SubStore) Subscription(ctx context.Context, id string) (*models.Subscription, error) {
 	const op = "repo.subscription.Subscription"
 
-	query := `
-		SELECT subscription_id, user_id, service_name, price, start_date, end_date
-		FROM subscriptions.subscriptions
-		WHERE subscription_id = $1
-	`
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions.subscriptions WHERE subscription_id = $1 AND deleted_at IS NULL`
 
-	var sub models.Subscription
-	err := s.storage.DB.QueryRow(query, id).Scan(
-		&sub.Id,
-		&sub.UserId,
-		&sub.ServiceName,
-		&sub.Price,
-		&sub.StartDate,
-		&sub.EndDate,
-	)
+	sub, err := scanSubscription(s.storage.Conn(ctx).QueryRowxContext(ctx, query, id))
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("%s: subscription not found", op)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, &models.NotFoundError{Entity: "subscription", ID: id})
 		}
 		slog.Error("Failed to get subscription",
 			slog.String("operation", op),
 			slog.String("subscription_id", id),
 			slog.Any("error", err))
-		return nil, fmt.Errorf("%s: failed to get subscription: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to get subscription: %w", op, storage.MapError(err))
 	}
 
 	slog.Debug("Subscription retrieved",
 		slog.String("operation", op),
-		slog.Int("subscription_id", sub.Id))
+		slog.String("subscription_id", sub.Id))
 
-	return &sub, nil
+	return sub, nil
+}
+
+// InTx runs fn in one transaction shared by every repository call made with the context it
+// receives.
+func (s *SubStore) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.storage.InTx(ctx, fn)
 }
 
-func (s *SubStore) DeleteSubscription(id string) error {
+// DeleteSubscription soft-deletes a subscription, keeping it for past summaries until it is
+// purged. A non-nil versions slice makes the delete conditional on the current version being
+// one of them.
+func (s *SubStore) DeleteSubscription(ctx context.Context, id string, versions []int) error {
 	const op = "repo.subscription.DeleteSubscription"
 
-	query := `DELETE FROM subscriptions.subscriptions WHERE subscription_id = $1`
+	err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockSubscription(ctx, tx, id, versions)
+		if err != nil {
+			return err
+		}
+
+		after, err := scanSubscription(tx.QueryRowContext(ctx, `
+			UPDATE subscriptions.subscriptions SET deleted_at = now(), version = version + 1
+			WHERE subscription_id = $1
+			RETURNING `+subscriptionColumns, id))
+		if err != nil {
+			return err
+		}
 
-	result, err := s.storage.DB.Exec(query, id)
+		return recordEvent(ctx, tx, models.EventDeleted, id, before, after)
+	})
 	if err != nil {
-		slog.Error("Failed to delete subscription",
+		slog.Warn("Failed to delete subscription",
 			slog.String("operation", op),
 			slog.String("subscription_id", id),
 			slog.Any("error", err))
-		return fmt.Errorf("%s: failed to delete subscription: %w", op, err)
+		return fmt.Errorf("%s: failed to delete subscription: %w", op, storage.MapError(err))
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	slog.Debug("Subscription deleted",
+		slog.String("operation", op),
+		slog.String("subscription_id", id))
+
+	return nil
+}
+
+// RestoreSubscription undoes a soft delete. Restoring a subscription that is not deleted
+// returns it unchanged.
+func (s *SubStore) RestoreSubscription(ctx context.Context, id string) (*models.Subscription, error) {
+	const op = "repo.subscription.RestoreSubscription"
+
+	var sub *models.Subscription
+	err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := scanSubscription(tx.QueryRowContext(ctx,
+			`SELECT `+subscriptionColumns+` FROM subscriptions.subscriptions WHERE subscription_id = $1 FOR UPDATE`,
+			id))
+		if errors.Is(err, sql.ErrNoRows) {
+			return &models.NotFoundError{Entity: "subscription", ID: id}
+		}
+		if err != nil {
+			return err
+		}
+
+		if before.DeletedAt == nil {
+			sub = before
+			return nil
+		}
+
+		sub, err = scanSubscription(tx.QueryRowContext(ctx, `
+			UPDATE subscriptions.subscriptions SET deleted_at = NULL, version = version + 1
+			WHERE subscription_id = $1
+			RETURNING `+subscriptionColumns, id))
+		if err != nil {
+			return err
+		}
+
+		return recordEvent(ctx, tx, models.EventRestored, id, before, sub)
+	})
 	if err != nil {
-		slog.Error("Failed to get affected rows when deleting subscription",
+		slog.Warn("Failed to restore subscription",
 			slog.String("operation", op),
 			slog.String("subscription_id", id),
 			slog.Any("error", err))
-		return fmt.Errorf("%s: failed to get affected rows: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to restore subscription: %w", op, storage.MapError(err))
 	}
 
-	if rowsAffected == 0 {
-		slog.Warn("Attempt to delete non-existent subscription",
+	slog.Debug("Subscription restored",
+		slog.String("operation", op),
+		slog.String("subscription_id", id))
+
+	return sub, nil
+}
+
+// PurgeSubscriptions hard-deletes subscriptions that were soft-deleted longer than the
+// configured retention ago and returns how many were removed.
+func (s *SubStore) PurgeSubscriptions(ctx context.Context) (int, error) {
+	const op = "repo.subscription.PurgeSubscriptions"
+
+	before := time.Now().Add(-s.storage.DeletedRetention)
+
+	purged, err := s.purge(ctx, `deleted_at < $1`, before)
+	if err != nil {
+		slog.Error("Failed to purge subscriptions",
 			slog.String("operation", op),
-			slog.String("subscription_id", id))
-		return fmt.Errorf("%s: subscription not found", op)
+			slog.Time("before", before),
+			slog.Any("error", err))
+		return 0, fmt.Errorf("%s: failed to purge subscriptions: %w", op, storage.MapError(err))
 	}
 
-	slog.Debug("Subscription deleted",
+	slog.Debug("Subscriptions purged",
 		slog.String("operation", op),
-		slog.String("subscription_id", id))
+		slog.Time("before", before),
+		slog.Int("purged", purged))
 
-	return nil
+	return purged, nil
 }
 
-func (s *SubStore) GetAllSubscriptions(userID *string, serviceName *string, limit, offset int) ([]*models.Subscription, int, error) {
-	const op = "repo.subscription.GetAllSubscriptions"
+// PurgeUserSubscriptions hard-deletes every subscription of a user, deleted or not, and returns
+// how many were removed.
+func (s *SubStore) PurgeUserSubscriptions(ctx context.Context, userID string) (int, error) {
+	const op = "repo.subscription.PurgeUserSubscriptions"
 
-	// Count query to get total number of subscriptions matching the filters
-	countQuery := `SELECT COUNT(*) FROM subscriptions.subscriptions WHERE true`
-	countArgs := []interface{}{}
-	countArgCount := 0
+	purged, err := s.purge(ctx, `user_id = $1`, userID)
+	if err != nil {
+		slog.Error("Failed to purge user subscriptions",
+			slog.String("operation", op),
+			slog.String("user_id", userID),
+			slog.Any("error", err))
+		return 0, fmt.Errorf("%s: failed to purge subscriptions: %w", op, storage.MapError(err))
+	}
 
-	// Main query with filters
-	query := `SELECT subscription_id, user_id, service_name, price, start_date, end_date FROM subscriptions.subscriptions WHERE true`
-	args := []interface{}{}
-	argCount := 0
+	slog.Debug("User subscriptions purged",
+		slog.String("operation", op),
+		slog.String("user_id", userID),
+		slog.Int("purged", purged))
 
-	if userID != nil {
-		query += fmt.Sprintf(" AND user_id = $%d", argCount+1)
-		args = append(args, *userID)
-		argCount++
+	return purged, nil
+}
 
-		countQuery += fmt.Sprintf(" AND user_id = $%d", countArgCount+1)
-		countArgs = append(countArgs, *userID)
-		countArgCount++
-	}
+// purgeBatchSize bounds how many subscriptions a purge or a rename holds in memory at once.
+const purgeBatchSize = 500
 
-	if serviceName != nil {
-		query += fmt.Sprintf(" AND service_name = $%d", argCount+1)
-		args = append(args, *serviceName)
-		argCount++
+// purge hard-deletes the subscriptions matching cond in batches, recording a purged event with
+// the last state of each in the transaction removing it. A transaction carried by ctx is shared
+// by all batches, otherwise each batch commits on its own.
+func (s *SubStore) purge(ctx context.Context, cond string, args ...interface{}) (int, error) {
+	query := `
+		DELETE FROM subscriptions.subscriptions WHERE subscription_id IN (
+			SELECT subscription_id FROM subscriptions.subscriptions
+			WHERE ` + cond + `
+			LIMIT ` + strconv.Itoa(purgeBatchSize) + `
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + subscriptionColumns
+
+	purged := 0
+	for {
+		var batch []*models.Subscription
+		err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+			rows, err := tx.QueryxContext(ctx, query, args...)
+			if err != nil {
+				return err
+			}
+			for rows.Next() {
+				sub, err := scanSubscription(rows)
+				if err != nil {
+					rows.Close()
+					return err
+				}
+				batch = append(batch, sub)
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return err
+			}
+
+			for _, sub := range batch {
+				if err := recordEvent(ctx, tx, models.EventPurged, sub.Id, sub, nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return purged, err
+		}
 
-		countQuery += fmt.Sprintf(" AND service_name = $%d", countArgCount+1)
-		countArgs = append(countArgs, *serviceName)
-		countArgCount++
+		purged += len(batch)
+		if len(batch) < purgeBatchSize {
+			return purged, nil
+		}
 	}
+}
 
-	query += fmt.Sprintf(" ORDER BY subscription_id LIMIT $%d OFFSET $%d", argCount+1, argCount+2)
-	args = append(args, limit, offset)
+// RenameService moves every subscription of a service, deleted ones included, to its new name
+// and bumps their versions, so cached copies and pending writes see the change. Each move is
+// recorded as an updated event. It returns how many were moved.
+func (s *SubStore) RenameService(ctx context.Context, name, newName string) (int, error) {
+	const op = "repo.subscription.RenameService"
+
+	// Moved rows no longer match the old name, so batches run until one comes back short
+	query := `
+		UPDATE subscriptions.subscriptions SET service_name = $2, version = version + 1
+		WHERE subscription_id IN (
+			SELECT subscription_id FROM subscriptions.subscriptions
+			WHERE service_name = $1
+			LIMIT ` + strconv.Itoa(purgeBatchSize) + `
+			FOR UPDATE
+		)
+		RETURNING ` + subscriptionColumns
+
+	moved := 0
+	err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		for {
+			rows, err := tx.QueryxContext(ctx, query, name, newName)
+			if err != nil {
+				return err
+			}
+			var batch []*models.Subscription
+			for rows.Next() {
+				sub, err := scanSubscription(rows)
+				if err != nil {
+					rows.Close()
+					return err
+				}
+				batch = append(batch, sub)
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return err
+			}
 
-	// Execute count query
-	var totalCount int
-	err := s.storage.DB.QueryRow(countQuery, countArgs...).Scan(&totalCount)
+			// Only the name and the version changed, so the state before is derived from after
+			for _, after := range batch {
+				before := *after
+				before.ServiceName = name
+				before.Version--
+				if err := recordEvent(ctx, tx, models.EventUpdated, after.Id, &before, after); err != nil {
+					return err
+				}
+			}
+
+			moved += len(batch)
+			if len(batch) < purgeBatchSize {
+				return nil
+			}
+		}
+	})
 	if err != nil {
-		slog.Error("Failed to count subscriptions",
+		slog.Error("Failed to move subscriptions to the renamed service",
 			slog.String("operation", op),
+			slog.String("service_name", name),
+			slog.String("new_service_name", newName),
 			slog.Any("error", err))
-		return nil, 0, fmt.Errorf("%s: failed to count subscriptions: %w", op, err)
+		return 0, fmt.Errorf("%s: failed to move subscriptions: %w", op, storage.MapError(err))
 	}
 
-	// Execute main query
-	rows, err := s.storage.DB.Query(query, args...)
+	slog.Debug("Subscriptions moved to the renamed service",
+		slog.String("operation", op),
+		slog.String("service_name", name),
+		slog.String("new_service_name", newName),
+		slog.Int("moved", moved))
+
+	return moved, nil
+}
+
+// GetAllSubscriptions returns a page of the subscriptions matching filter in the requested sort,
+// selected by offset or by the cursor of the previous page. A cursor for the following page is
+// returned whenever there is one, so offset clients can switch over to cursors.
+func (s *SubStore) GetAllSubscriptions(ctx context.Context, filter models.SubscriptionFilter, page models.PageRequest) ([]*models.Subscription, models.PageInfo, error) {
+	const op = "repo.subscription.GetAllSubscriptions"
+
+	// The default sort is spelled out so its cursors match an explicit start_date
+	if page.Sort == "" {
+		page.Sort = models.SortStartDate
+	}
+	keys, err := parseSort(page.Sort)
+	if err != nil {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Filters shared by the count and the page query
+	w, err := subscriptionFilter(filter)
+	if err != nil {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var info models.PageInfo
+	if !page.SkipTotal {
+		var totalCount int
+		countQuery := `SELECT COUNT(*) FROM subscriptions.subscriptions WHERE ` + w.sql()
+		err := s.storage.DB.QueryRowContext(ctx, countQuery, w.args...).Scan(&totalCount)
+		if err != nil {
+			slog.Error("Failed to count subscriptions",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, models.PageInfo{}, fmt.Errorf("%s: failed to count subscriptions: %w", op, storage.MapError(err))
+		}
+		info.Total = &totalCount
+	}
+
+	w = w.clone()
+	if page.Cursor != "" {
+		values, id, err := decodeCursor(page.Cursor, page.Sort, keys)
+		if err != nil {
+			return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, err)
+		}
+		addAfter(w, keys, values, id)
+	}
+
+	// One row past the page tells whether there is a next one
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions.subscriptions WHERE ` + w.sql() +
+		` ORDER BY ` + orderBy(keys) +
+		` LIMIT ` + w.bind(page.Limit+1) + ` OFFSET ` + w.bind(page.Offset)
+
+	rows, err := s.storage.DB.QueryContext(ctx, query, w.args...)
 	if err != nil {
 		slog.Error("Failed to query subscriptions",
 			slog.String("operation", op),
 			slog.Any("error", err))
-		return nil, 0, fmt.Errorf("%s: failed to query subscriptions: %w", op, err)
+		return nil, models.PageInfo{}, fmt.Errorf("%s: failed to query subscriptions: %w", op, storage.MapError(err))
 	}
 	defer rows.Close()
 
-	var subscriptions []*models.Subscription
+	subscriptions := []*models.Subscription{}
 	for rows.Next() {
-		var sub models.Subscription
-		err := rows.Scan(
-			&sub.Id,
-			&sub.UserId,
-			&sub.ServiceName,
-			&sub.Price,
-			&sub.StartDate,
-			&sub.EndDate,
-		)
+		sub, err := scanSubscription(rows)
 		if err != nil {
 			slog.Error("Failed to scan subscription",
 				slog.String("operation", op),
 				slog.Any("error", err))
-			return nil, 0, fmt.Errorf("%s: failed to scan subscription: %w", op, err)
+			return nil, models.PageInfo{}, fmt.Errorf("%s: failed to scan subscription: %w", op, err)
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: failed to iterate subscriptions: %w", op, err)
+	}
+
+	if len(subscriptions) > page.Limit {
+		subscriptions = subscriptions[:page.Limit]
+		if page.Limit > 0 {
+			info.NextCursor, err = encodeCursor(page.Sort, keys, subscriptions[page.Limit-1])
+			if err != nil {
+				return nil, models.PageInfo{}, fmt.Errorf("%s: failed to encode cursor: %w", op, err)
+			}
 		}
-		subscriptions = append(subscriptions, &sub)
 	}
 
 	slog.Debug("Fetched subscriptions",
 		slog.String("operation", op),
 		slog.Int("count", len(subscriptions)),
-		slog.Int("total_count", totalCount))
+		slog.Bool("has_next", info.NextCursor != ""))
+
+	return subscriptions, info, nil
+}
+
+// subscriptionFilter turns a list filter into query conditions.
+func subscriptionFilter(filter models.SubscriptionFilter) (*where, error) {
+	w := &where{}
+
+	if !filter.IncludeDeleted {
+		w.add("deleted_at IS NULL")
+	}
+	if len(filter.UserIDs) > 0 {
+		w.add("user_id = ANY(?::uuid[])", pq.Array(filter.UserIDs))
+	}
+	if filter.ServiceName != nil {
+		w.add("service_name = ?", *filter.ServiceName)
+	}
+	if filter.ServiceNamePrefix != nil {
+		w.add("service_name ILIKE ?", escapeLike(*filter.ServiceNamePrefix)+"%")
+	}
+	if filter.MinPrice != nil {
+		w.add("price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		w.add("price <= ?", *filter.MaxPrice)
+	}
+
+	if filter.Status != nil {
+		cond, ok := statusConditions[*filter.Status]
+		if !ok {
+			return nil, &models.ValidationError{Field: "status", Message: "unknown status"}
+		}
+		w.add(cond)
+	}
 
-	return subscriptions, totalCount, nil
+	if filter.ActiveAt != nil {
+		// A day is both bounds, a legacy month spans from its first to its last day
+		from, err := parseDate("active_at", *filter.ActiveAt)
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseEndDate("active_at", *filter.ActiveAt)
+		if err != nil {
+			return nil, err
+		}
+		w.add("start_date <= ? AND (end_date IS NULL OR end_date >= ?)", to, from)
+	}
+
+	// Lower bounds take the first day of a legacy month, upper bounds its last day
+	bounds := []struct {
+		field, cond string
+		value       *string
+		parse       func(field, value string) (models.Date, error)
+	}{
+		{"start_date_from", "start_date >= ?", filter.StartDateFrom, parseDate},
+		{"start_date_to", "start_date <= ?", filter.StartDateTo, parseEndDate},
+		{"end_date_from", "end_date >= ?", filter.EndDateFrom, parseDate},
+		{"end_date_to", "end_date <= ?", filter.EndDateTo, parseEndDate},
+	}
+	for _, b := range bounds {
+		if b.value == nil {
+			continue
+		}
+		day, err := b.parse(b.field, *b.value)
+		if err != nil {
+			return nil, err
+		}
+		w.add(b.cond, day)
+	}
+
+	return w, nil
 }
 
-func (s *SubStore) UpdateSubscription(id, serviceName *string, price *int, userID *string, startDate *string, endDate *string) (*models.Subscription, error) {
+// UpdateSubscription changes the given fields and bumps the version. A new price or currency
+// does not rewrite history, it starts a price period from priceFrom, by default the current month.
+// A non-nil versions slice makes the update conditional on the current version being one of them.
+func (s *SubStore) UpdateSubscription(ctx context.Context, id, serviceName *string, price *int, currency *string, priceFrom *string, billingPeriod *string, billingInterval *int, userID *string, startDate *string, endDate *string, versions []int) (*models.Subscription, error) {
 	const op = "repo.subscription.UpdateSubscription"
 
+	var priceFromTime *models.Date
+	if priceFrom != nil {
+		t, err := parseDate("price_effective_from", *priceFrom)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		priceFromTime = &t
+	}
+
 	// Build the dynamic query and arguments
 	query := "UPDATE subscriptions.subscriptions SET "
 	args := []interface{}{}
@@ -238,9 +578,19 @@ func (s *SubStore) UpdateSubscription(id, serviceName *string, price *int, userI
 		args = append(args, *serviceName)
 		argCount++
 	}
-	if price != nil {
-		query += fmt.Sprintf("price = $%d, ", argCount+1)
-		args = append(args, *price)
+	repriced := price != nil || currency != nil
+	if repriced {
+		// The columns mirror the latest price period, written below before this query runs
+		query += "(price, currency) = (" + latestPriceQuery + "), "
+	}
+	if billingPeriod != nil {
+		query += fmt.Sprintf("billing_period = $%d, ", argCount+1)
+		args = append(args, *billingPeriod)
+		argCount++
+	}
+	if billingInterval != nil {
+		query += fmt.Sprintf("billing_interval = $%d, ", argCount+1)
+		args = append(args, *billingInterval)
 		argCount++
 	}
 	if userID != nil {
@@ -248,122 +598,549 @@ func (s *SubStore) UpdateSubscription(id, serviceName *string, price *int, userI
 		args = append(args, *userID)
 		argCount++
 	}
+	var startTime *models.Date
 	if startDate != nil {
-		parsedStartDate, err := time.Parse("01-2006", *startDate)
+		parsedStartDate, err := parseDate("start_date", *startDate)
 		if err != nil {
-			return nil, fmt.Errorf("%s: invalid start date format: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		query += fmt.Sprintf("start_date = $%d, ", argCount+1)
 		args = append(args, parsedStartDate)
 		argCount++
+		startTime = &parsedStartDate
 	}
 	if endDate != nil {
-		var parsedEndDate *time.Time
+		var parsedEndDate *models.Date
 		if *endDate != "" {
-			t, err := time.Parse("01-2006", *endDate)
+			t, err := parseEndDate("end_date", *endDate)
 			if err != nil {
-				return nil, fmt.Errorf("%s: invalid end date format: %w", op, err)
+				return nil, fmt.Errorf("%s: %w", op, err)
 			}
 			parsedEndDate = &t
-		} else {
-			parsedEndDate = nil
 		}
 		query += fmt.Sprintf("end_date = $%d, ", argCount+1)
 		args = append(args, parsedEndDate)
 		argCount++
 	}
 
-	// Remove trailing comma and space
-	if len(args) > 0 {
-		query = query[:len(query)-2]
+	// With no fields to change the current row is returned as is, still honouring versions
+	changed := len(args) > 0 || repriced
+	if changed {
+		query += fmt.Sprintf("version = version + 1 WHERE subscription_id = $%d RETURNING %s", argCount+1, subscriptionColumns)
 	} else {
-		// If no fields to update, return early
-		sub, err := s.Subscription(id)
+		query = `SELECT ` + subscriptionColumns + ` FROM subscriptions.subscriptions WHERE subscription_id = $1`
+	}
+	args = append(args, *id)
+
+	var updatedSub *models.Subscription
+	err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockSubscription(ctx, tx, *id, versions)
 		if err != nil {
-			return nil, fmt.Errorf("%s: subscription not found: %w", op, err)
+			return err
+		}
+		if err := s.ensureReferences(ctx, tx, userID, serviceName); err != nil {
+			return err
+		}
+
+		// Repeating the current price, e.g. in a full replacement, does not start a new period
+		newPrice, newCurrency := before.Price, before.Currency
+		if price != nil {
+			newPrice = *price
+		}
+		if currency != nil {
+			newCurrency = *currency
+		}
+		if repriced && (priceFromTime != nil || newPrice != before.Price || newCurrency != before.Currency) {
+			start := before.StartDate
+			if startTime != nil {
+				start = *startTime
+			}
+			if err := setPrice(ctx, tx, *id, priceEffectiveFrom(priceFromTime, start), newPrice, newCurrency); err != nil {
+				return err
+			}
 		}
-		return sub, nil
-	}
 
-	// Add WHERE clause
-	query += fmt.Sprintf(" WHERE subscription_id = $%d RETURNING subscription_id, user_id, service_name, price, start_date, end_date", argCount+1)
-	args = append(args, id)
+		updatedSub, err = scanSubscription(tx.QueryRowContext(ctx, query, args...))
+		if err != nil || !changed {
+			return err
+		}
 
-	var updatedSub models.Subscription
-	err := s.storage.DB.QueryRow(query, args...).Scan(
-		&updatedSub.Id,
-		&updatedSub.UserId,
-		&updatedSub.ServiceName,
-		&updatedSub.Price,
-		&updatedSub.StartDate,
-		&updatedSub.EndDate,
-	)
+		return recordEvent(ctx, tx, models.EventUpdated, *id, before, updatedSub)
+	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			slog.Warn("Attempt to update non-existent subscription",
-				slog.String("operation", op),
-				slog.String("subscription_id", *id))
-			return nil, fmt.Errorf("%s: subscription not found", op)
-		}
-		slog.Error("Failed to update subscription",
+		slog.Warn("Failed to update subscription",
 			slog.String("operation", op),
 			slog.String("subscription_id", *id),
 			slog.Any("error", err))
-		return nil, fmt.Errorf("%s: failed to update subscription: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to update subscription: %w", op, storage.MapError(err))
 	}
 
 	slog.Debug("Subscription updated",
 		slog.String("operation", op),
-		slog.Int("subscription_id", updatedSub.Id))
+		slog.String("subscription_id", updatedSub.Id),
+		slog.Int("version", updatedSub.Version),
+		slog.Bool("changed", changed))
 
-	return &updatedSub, nil
+	return updatedSub, nil
 }
 
-func (s *SubStore) SummarySubscription(startDate, endDate string, userID *string, serviceName *string) (int, error) {
+func (s *SubStore) SummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) (*models.Summary, error) {
 	const op = "repo.subscription.SummarySubscription"
 
 	// Parse start and end dates
-	startTime, err := time.Parse("01-2006", startDate)
+	startTime, endTime, err := parsePeriod(startDate, endDate)
 	if err != nil {
-		return 0, fmt.Errorf("%s: invalid start date format: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	active, args := chargesQuery(startTime, endTime, currency, proration, userID, serviceName, includeDeleted)
+	if err := s.checkRates(ctx, active, args); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.MapError(err))
 	}
-	endTime, err := time.Parse("01-2006", endDate)
+	query := active + `
+		SELECT COALESCE(SUM(cost), 0)::bigint, COALESCE(SUM(months), 0)::bigint, COALESCE(SUM(charges), 0)::bigint
+		FROM active
+	`
+
+	summary := models.Summary{Currency: currency, Months: monthsBetween(startTime, endTime)}
+	err = s.storage.DB.QueryRowContext(ctx, query, args...).Scan(&summary.TotalCost, &summary.SubscriptionMonths, &summary.Charges)
 	if err != nil {
-		return 0, fmt.Errorf("%s: invalid end date format: %w", op, err)
+		slog.Error("Failed to calculate subscription summary",
+			slog.String("operation", op),
+			slog.String("start_date", startDate),
+			slog.String("end_date", endDate),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to calculate summary: %w", op, storage.MapError(err))
 	}
 
-	query := `SELECT COALESCE(SUM(price), 0) FROM subscriptions.subscriptions WHERE start_date <= $1 AND ($2 IS NULL OR end_date >= $3 OR end_date IS NULL)`
-	args := []interface{}{endTime, endTime, startTime}
-	argCount := 3
+	slog.Debug("Subscription summary calculated",
+		slog.String("operation", op),
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate),
+		slog.Int("summary", summary.TotalCost),
+		slog.Int("months", summary.Months))
 
-	if userID != nil {
-		argCount++
-		query += fmt.Sprintf(" AND user_id = $%d", argCount)
-		args = append(args, *userID)
+	return &summary, nil
+}
+
+// MonthlySummarySubscription returns the cost of every month of the window and the total over
+// all of them. The total is rounded once from the unrounded costs, so it matches the summary of
+// the same window rather than the sum of the rounded months.
+func (s *SubStore) MonthlySummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.MonthlyCost, int, error) {
+	const op = "repo.subscription.MonthlySummarySubscription"
+
+	// Parse start and end dates
+	startTime, endTime, err := parsePeriod(startDate, endDate)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if serviceName != nil {
-		argCount++
-		query += fmt.Sprintf(" AND service_name = $%d", argCount)
-		args = append(args, *serviceName)
+	// Every month of the window is listed, including those without subscriptions
+	active, args := chargesQuery(startTime, endTime, currency, proration, userID, serviceName, includeDeleted)
+	if err := s.checkRates(ctx, active, args); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, storage.MapError(err))
+	}
+	query := active + `
+		SELECT to_char(m.month, 'MM-YYYY'), COALESCE(SUM(p.price), 0)::bigint, COUNT(DISTINCT p.subscription_id),
+			(SELECT COALESCE(SUM(cost), 0) FROM active)::bigint
+		FROM generate_series(
+			date_trunc('month', $1::timestamptz AT TIME ZONE 'UTC'),
+			date_trunc('month', $2::timestamptz AT TIME ZONE 'UTC'),
+			INTERVAL '1 month'
+		) AS m(month)
+		LEFT JOIN priced p ON p.month = m.month
+		GROUP BY m.month
+		ORDER BY m.month
+	`
+
+	rows, err := s.storage.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.Error("Failed to query monthly subscription summary",
+			slog.String("operation", op),
+			slog.String("start_date", startDate),
+			slog.String("end_date", endDate),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to calculate monthly summary: %w", op, storage.MapError(err))
+	}
+	defer rows.Close()
+
+	months := make([]*models.MonthlyCost, 0, monthsBetween(startTime, endTime))
+	total := 0
+	for rows.Next() {
+		var month models.MonthlyCost
+		if err := rows.Scan(&month.Month, &month.Cost, &month.Subscriptions, &total); err != nil {
+			slog.Error("Failed to scan monthly summary",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, 0, fmt.Errorf("%s: failed to scan monthly summary: %w", op, err)
+		}
+		months = append(months, &month)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: failed to iterate monthly summary: %w", op, err)
+	}
+
+	slog.Debug("Monthly subscription summary calculated",
+		slog.String("operation", op),
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate),
+		slog.Int("months", len(months)),
+		slog.Int("total_cost", total))
+
+	return months, total, nil
+}
+
+// GroupedSummarySubscription returns the cost of every group over the window and the total over
+// all of them, rounded once from the unrounded costs like the monthly total.
+func (s *SubStore) GroupedSummarySubscription(ctx context.Context, startDate, endDate string, groupBy, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.GroupCost, int, error) {
+	const op = "repo.subscription.GroupedSummarySubscription"
 
-	var total int
-	err = s.storage.DB.QueryRow(query, args...).Scan(&total)
+	column, ok := summaryGroupColumns[groupBy]
+	if !ok {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "group_by", Message: "unsupported group_by value"})
+	}
+
+	// Parse start and end dates
+	startTime, endTime, err := parsePeriod(startDate, endDate)
 	if err != nil {
-		slog.Error("Failed to calculate subscription summary",
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	active, args := chargesQuery(startTime, endTime, currency, proration, userID, serviceName, includeDeleted)
+	if err := s.checkRates(ctx, active, args); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, storage.MapError(err))
+	}
+	query := active + `
+		SELECT ` + column + `::text,
+			SUM(cost)::bigint AS cost,
+			COUNT(*),
+			COALESCE(ROUND(SUM(cost)::numeric / NULLIF(SUM(SUM(cost)) OVER (), 0), 4), 0)::float8,
+			(SUM(SUM(cost)) OVER ())::bigint
+		FROM active
+		GROUP BY ` + column + `
+		ORDER BY cost DESC, 1
+	`
+
+	rows, err := s.storage.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.Error("Failed to query grouped subscription summary",
 			slog.String("operation", op),
+			slog.String("group_by", groupBy),
 			slog.String("start_date", startDate),
 			slog.String("end_date", endDate),
 			slog.Any("error", err))
-		return 0, fmt.Errorf("%s: failed to calculate summary: %w", op, err)
+		return nil, 0, fmt.Errorf("%s: failed to calculate grouped summary: %w", op, storage.MapError(err))
 	}
+	defer rows.Close()
 
-	slog.Debug("Subscription summary calculated",
+	groups := []*models.GroupCost{}
+	total := 0
+	for rows.Next() {
+		var group models.GroupCost
+		if err := rows.Scan(&group.Key, &group.Cost, &group.Subscriptions, &group.Share, &total); err != nil {
+			slog.Error("Failed to scan grouped summary",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, 0, fmt.Errorf("%s: failed to scan grouped summary: %w", op, err)
+		}
+		groups = append(groups, &group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: failed to iterate grouped summary: %w", op, err)
+	}
+
+	slog.Debug("Grouped subscription summary calculated",
 		slog.String("operation", op),
+		slog.String("group_by", groupBy),
 		slog.String("start_date", startDate),
 		slog.String("end_date", endDate),
-		slog.Int("summary", total))
+		slog.Int("groups", len(groups)),
+		slog.Int("total_cost", total))
+
+	return groups, total, nil
+}
 
-	return total, nil
+// ensureReferences registers the user and service a subscription points to, so the foreign keys
+// on subscriptions.subscriptions hold. In strict mode nothing is created and unknown references
+// surface as foreign key violations.
+func (s *SubStore) ensureReferences(ctx context.Context, tx *sqlx.Tx, userID, serviceName *string) error {
+	if s.storage.StrictReferences {
+		return nil
+	}
+
+	if serviceName != nil {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO subscriptions.services (service_name) VALUES ($1) ON CONFLICT DO NOTHING`,
+			*serviceName)
+		if err != nil {
+			return fmt.Errorf("failed to register service: %w", err)
+		}
+	}
+
+	if userID != nil {
+		// Users are managed outside of this service, the ID doubles as a placeholder username
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO subscriptions.users (user_id, username) VALUES ($1, $1::text) ON CONFLICT DO NOTHING`,
+			*userID)
+		if err != nil {
+			return fmt.Errorf("failed to register user: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// summaryGroupColumns whitelists the columns a summary can be grouped by.
+var summaryGroupColumns = map[string]string{
+	models.GroupByServiceName: "service_name",
+	models.GroupByUserID:      "user_id",
+}
+
+// chargesQuery builds the "priced" CTE with the cost of each subscription inside the window
+// [from, to], both whole days, split by calendar month, and the "active" CTE with one row per
+// subscription active in the window with its cost, charges and active months. Billing dates are
+// start_date plus whole billing intervals. With ProrationNone each billing date inside the window
+// is charged in full, with ProrationDaily each billing period's price is spread evenly over its
+// days and only the days inside both the window and the subscription count. Open-ended
+// subscriptions run until the end of the window and soft-deleted ones count only when
+// includeDeleted. Charges before the first price period use the earliest known price. Prices are
+// converted through the base currency with the rates in effect that month, a missing rate leaves
+// the price NULL, see checkRates.
+func chargesQuery(from, to models.Date, currency, proration string, userID *string, serviceName *string, includeDeleted bool) (string, []interface{}) {
+	filter := `start_date <= $2::timestamptz AND (end_date IS NULL OR end_date >= $1::timestamptz)`
+	args := []interface{}{from, to, currency, models.BaseCurrency}
+	argCount := 4
+
+	if !includeDeleted {
+		filter += " AND deleted_at IS NULL"
+	}
+
+	if userID != nil {
+		argCount++
+		filter += fmt.Sprintf(" AND user_id = $%d", argCount)
+		args = append(args, *userID)
+	}
+
+	if serviceName != nil {
+		argCount++
+		filter += fmt.Sprintf(" AND service_name = $%d", argCount)
+		args = append(args, *serviceName)
+	}
+
+	// Billing periods overlapping the window when prorating, billing dates inside it otherwise
+	charged := `c.charged_at >= b.from_day AND c.charged_at < b.to_end`
+	segments := `
+			SELECT c.*, date_trunc('month', c.charged_at) AS month, 1::numeric AS share
+			FROM charges c`
+	if proration == models.ProrationDaily {
+		charged = `c.charged_at < b.to_end AND c.next_at > b.from_day`
+		segments = `
+			SELECT c.*, m.month,
+				(LEAST(c.next_at, c.to_end, m.month + INTERVAL '1 month')::date
+					- GREATEST(c.charged_at, c.from_day, m.month)::date)::numeric
+					/ (c.next_at::date - c.charged_at::date) AS share
+			FROM charges c
+			CROSS JOIN LATERAL generate_series(
+				date_trunc('month', GREATEST(c.charged_at, c.from_day)),
+				date_trunc('month', LEAST(c.next_at, c.to_end) - INTERVAL '1 day'),
+				INTERVAL '1 month'
+			) AS m(month)`
+	}
+
+	query := `
+		WITH bounds AS (
+			SELECT subscription_id, user_id, service_name, price, currency,
+				start_date AT TIME ZONE 'UTC' AS start_at,
+				CASE billing_period WHEN 'monthly' THEN 1 WHEN 'quarterly' THEN 3 WHEN 'yearly' THEN 12 ELSE 0 END
+					* billing_interval AS step_months,
+				CASE billing_period WHEN 'weekly' THEN 7 * billing_interval ELSE 0 END AS step_days,
+				GREATEST(start_date, $1::timestamptz) AT TIME ZONE 'UTC' AS from_day,
+				LEAST(COALESCE(end_date, $2::timestamptz), $2::timestamptz) AT TIME ZONE 'UTC' AS to_day,
+				(LEAST(COALESCE(end_date, $2::timestamptz), $2::timestamptz) AT TIME ZONE 'UTC') + INTERVAL '1 day' AS to_end
+			FROM subscriptions.subscriptions
+			WHERE ` + filter + `
+		), charges AS (
+			SELECT b.subscription_id, b.user_id, b.service_name, b.price, b.currency, b.from_day, b.to_end,
+				c.charged_at, c.next_at
+			FROM bounds b
+			CROSS JOIN LATERAL (
+				SELECT CASE WHEN b.step_months > 0
+						THEN ` + monthsDiff("b.start_at", "b.from_day") + ` / b.step_months
+						ELSE (b.from_day::date - b.start_at::date) / b.step_days
+					END AS first_period,
+					CASE WHEN b.step_months > 0
+						THEN ` + monthsDiff("b.start_at", "b.to_day") + ` / b.step_months
+						ELSE (b.to_end::date - b.start_at::date) / b.step_days
+					END AS last_period
+			) r
+			CROSS JOIN LATERAL generate_series(GREATEST(r.first_period - 1, 0), r.last_period + 1) AS k(period)
+			CROSS JOIN LATERAL (
+				SELECT b.start_at + k.period * make_interval(months => b.step_months, days => b.step_days) AS charged_at,
+					b.start_at + (k.period + 1) * make_interval(months => b.step_months, days => b.step_days) AS next_at
+			) c
+			WHERE ` + charged + `
+		), segments AS (` + segments + `
+		), periods AS (
+			SELECT s.subscription_id, s.user_id, s.service_name, s.charged_at, s.month, s.share,
+				COALESCE(pp.price, s.price) AS amount,
+				COALESCE(pp.currency, s.currency)::text AS currency
+			FROM segments s
+			LEFT JOIN LATERAL (
+				SELECT p.price, p.currency FROM subscriptions.subscription_prices p
+				WHERE p.subscription_id = s.subscription_id
+				ORDER BY (p.effective_from AT TIME ZONE 'UTC') <= s.charged_at DESC,
+					CASE WHEN (p.effective_from AT TIME ZONE 'UTC') <= s.charged_at THEN p.effective_from END DESC,
+					p.effective_from
+				LIMIT 1
+			) pp ON true
+		), rated AS (
+			SELECT p.*,
+				CASE WHEN p.currency = $4::text THEN 1 ELSE (
+					SELECT r.rate FROM subscriptions.exchange_rates r
+					WHERE r.currency = p.currency AND (r.effective_from AT TIME ZONE 'UTC') <= GREATEST(p.charged_at, p.month)
+					ORDER BY r.effective_from DESC LIMIT 1
+				) END AS src_rate,
+				CASE WHEN $3::text = $4::text THEN 1 ELSE (
+					SELECT r.rate FROM subscriptions.exchange_rates r
+					WHERE r.currency = $3::text AND (r.effective_from AT TIME ZONE 'UTC') <= GREATEST(p.charged_at, p.month)
+					ORDER BY r.effective_from DESC LIMIT 1
+				) END AS dst_rate
+			FROM periods p
+		), priced AS (
+			SELECT subscription_id, user_id, service_name, charged_at, month, currency, src_rate, dst_rate,
+				CASE WHEN currency = $3::text THEN amount * share ELSE amount * share * src_rate / dst_rate END AS price
+			FROM rated
+		), active AS (
+			SELECT b.subscription_id, b.user_id, b.service_name,
+				COALESCE(SUM(p.price), 0) AS cost,
+				COUNT(DISTINCT p.charged_at) FILTER (WHERE p.charged_at >= b.from_day AND p.charged_at < b.to_end) AS charges,
+				` + monthsDiff("b.from_day", "b.to_day") + ` + 1 AS months
+			FROM bounds b
+			LEFT JOIN priced p ON p.subscription_id = b.subscription_id
+			GROUP BY b.subscription_id, b.user_id, b.service_name, b.from_day, b.to_day, b.to_end
+		)`
+
+	return query, args
+}
+
+// monthsDiff is the SQL expression for the number of calendar months from one timestamp to another.
+func monthsDiff(from, to string) string {
+	return fmt.Sprintf("((date_part('year', %[2]s) - date_part('year', %[1]s)) * 12 + date_part('month', %[2]s) - date_part('month', %[1]s))::int", from, to)
+}
+
+// checkRates reports the first month of the window a price cannot be converted in for lack of
+// an exchange rate, as a validation error on the currency parameter.
+func (s *SubStore) checkRates(ctx context.Context, active string, args []interface{}) error {
+	var currency, month string
+	err := s.storage.DB.QueryRowContext(ctx, active+`
+		SELECT CASE WHEN src_rate IS NULL THEN currency ELSE $3::text END, to_char(month, 'MM-YYYY')
+		FROM priced
+		WHERE price IS NULL
+		ORDER BY month
+		LIMIT 1
+	`, args...).Scan(&currency, &month)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check exchange rates: %w", err)
+	}
+
+	return &models.ValidationError{
+		Field:   "currency",
+		Message: fmt.Sprintf("no exchange rate for %s in %s", currency, month),
+	}
+}
+
+// parseDate parses a date such as "2025-07-20", or a legacy "MM-YYYY" month standing for its
+// first day, naming field in the validation error.
+func parseDate(field, value string) (models.Date, error) {
+	d, err := models.ParseDate(value)
+	if err != nil {
+		return models.Date{}, &models.ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("invalid %s format, expected YYYY-MM-DD or MM-YYYY", field),
+		}
+	}
+	return d, nil
+}
+
+// parseEndDate parses an inclusive end date like parseDate, but a legacy month stands for its
+// last day.
+func parseEndDate(field, value string) (models.Date, error) {
+	d, err := models.ParseEndDate(value)
+	if err != nil {
+		return models.Date{}, &models.ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("invalid %s format, expected YYYY-MM-DD or MM-YYYY", field),
+		}
+	}
+	return d, nil
+}
+
+// parsePeriod parses the bounds of a summary window and checks that they are ordered.
+func parsePeriod(startDate, endDate string) (models.Date, models.Date, error) {
+	startTime, err := parseDate("start_date", startDate)
+	if err != nil {
+		return models.Date{}, models.Date{}, err
+	}
+	endTime, err := parseEndDate("end_date", endDate)
+	if err != nil {
+		return models.Date{}, models.Date{}, err
+	}
+	if endTime.Before(startTime.Time) {
+		return models.Date{}, models.Date{}, &models.ValidationError{Field: "end_date", Message: "end_date is before start_date"}
+	}
+	return startTime, endTime, nil
+}
+
+// monthsBetween returns the number of calendar months in [from, to], both ends inclusive.
+func monthsBetween(from, to models.Date) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month()) + 1
+}
+
+// lockSubscription locks the subscription row for the rest of the transaction and checks its
+// version against the expected ones, nil versions accept any. Soft-deleted rows count as missing.
+func lockSubscription(ctx context.Context, tx *sqlx.Tx, id string, versions []int) (*models.Subscription, error) {
+	sub, err := scanSubscription(tx.QueryRowContext(ctx,
+		`SELECT `+subscriptionColumns+` FROM subscriptions.subscriptions WHERE subscription_id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &models.NotFoundError{Entity: "subscription", ID: id}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if versions != nil && !slices.Contains(versions, sub.Version) {
+		return nil, &models.PreconditionError{Entity: "subscription", ID: id, Version: sub.Version}
+	}
+
+	return sub, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSubscription(row rowScanner) (*models.Subscription, error) {
+	var sub models.Subscription
+	err := row.Scan(
+		&sub.Id,
+		&sub.UserId,
+		&sub.ServiceName,
+		&sub.Price,
+		&sub.Currency,
+		&sub.BillingPeriod,
+		&sub.BillingInterval,
+		&sub.StartDate,
+		&sub.EndDate,
+		&sub.Version,
+		&sub.DeletedAt,
+		&sub.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &sub, nil
 }
@@ -0,0 +1,172 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	storage "github.com/DenHax/subscription-manager/internal/storage/postgres"
+	"github.com/jmoiron/sqlx"
+)
+
+type UserStore struct {
+	storage *storage.Storage
+}
+
+func NewUserStorage(s *storage.Storage) *UserStore {
+	return &UserStore{storage: s}
+}
+
+func (s *UserStore) CreateUser(ctx context.Context, userID *string, username string) (*models.User, error) {
+	const op = "repo.user.CreateUser"
+
+	// Users provisioned together with a subscription carry their ID as a placeholder
+	// username, registering such a user claims it; any other existing user is a conflict
+	query := `
+		INSERT INTO subscriptions.users (user_id, username)
+		VALUES (COALESCE($1::uuid, gen_random_uuid()), $2)
+		ON CONFLICT (user_id) DO UPDATE SET username = EXCLUDED.username
+			WHERE users.username = users.user_id::text
+		RETURNING user_id, username
+	`
+
+	var user models.User
+	err := s.storage.DB.QueryRowContext(ctx, query, userID, username).Scan(&user.Id, &user.Username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, &models.ConflictError{
+				Message: fmt.Sprintf("user %s is already registered", *userID),
+			})
+		}
+		slog.Error("Failed to create user",
+			slog.String("operation", op),
+			slog.String("username", username),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to create user: %w", op, storage.MapError(err))
+	}
+
+	slog.Debug("User created",
+		slog.String("operation", op),
+		slog.String("user_id", user.Id))
+
+	return &user, nil
+}
+
+func (s *UserStore) User(ctx context.Context, id string) (*models.User, error) {
+	const op = "repo.user.User"
+
+	query := `SELECT user_id, username FROM subscriptions.users WHERE user_id = $1`
+
+	var user models.User
+	err := s.storage.DB.QueryRowContext(ctx, query, id).Scan(&user.Id, &user.Username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, &models.NotFoundError{Entity: "user", ID: id})
+		}
+		slog.Error("Failed to get user",
+			slog.String("operation", op),
+			slog.String("user_id", id),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to get user: %w", op, storage.MapError(err))
+	}
+
+	return &user, nil
+}
+
+func (s *UserStore) GetAllUsers(ctx context.Context, limit, offset int) ([]*models.User, int, error) {
+	const op = "repo.user.GetAllUsers"
+
+	var total int
+	err := s.storage.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM subscriptions.users`).Scan(&total)
+	if err != nil {
+		slog.Error("Failed to count users",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to count users: %w", op, storage.MapError(err))
+	}
+
+	query := `SELECT user_id, username FROM subscriptions.users ORDER BY username, user_id LIMIT $1 OFFSET $2`
+
+	rows, err := s.storage.DB.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		slog.Error("Failed to query users",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to query users: %w", op, storage.MapError(err))
+	}
+	defer rows.Close()
+
+	users := []*models.User{}
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.Id, &user.Username); err != nil {
+			slog.Error("Failed to scan user",
+				slog.String("operation", op),
+				slog.Any("error", err))
+			return nil, 0, fmt.Errorf("%s: failed to scan user: %w", op, err)
+		}
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: failed to iterate users: %w", op, err)
+	}
+
+	slog.Debug("Fetched users",
+		slog.String("operation", op),
+		slog.Int("count", len(users)),
+		slog.Int("total_count", total))
+
+	return users, total, nil
+}
+
+// DeleteUser deletes a user without any subscriptions left. Soft-deleted subscriptions count
+// too, they are only removed once purged.
+func (s *UserStore) DeleteUser(ctx context.Context, id string) error {
+	const op = "repo.user.DeleteUser"
+
+	err := s.storage.WithTx(ctx, func(tx *sqlx.Tx) error {
+		// Lock the user so no subscription can be attached while we check
+		var locked string
+		err := tx.QueryRowContext(ctx,
+			`SELECT user_id FROM subscriptions.users WHERE user_id = $1 FOR UPDATE`,
+			id).Scan(&locked)
+		if errors.Is(err, sql.ErrNoRows) {
+			return &models.NotFoundError{Entity: "user", ID: id}
+		}
+		if err != nil {
+			return err
+		}
+
+		var count int
+		err = tx.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM subscriptions.subscriptions WHERE user_id = $1`,
+			id).Scan(&count)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return &models.ConflictError{
+				Message: fmt.Sprintf("user %s has %d subscriptions, delete and purge them or use cascade", id, count),
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM subscriptions.users WHERE user_id = $1`, id)
+		return err
+	})
+	if err != nil {
+		slog.Warn("Failed to delete user",
+			slog.String("operation", op),
+			slog.String("user_id", id),
+			slog.Any("error", err))
+		return fmt.Errorf("%s: failed to delete user: %w", op, storage.MapError(err))
+	}
+
+	slog.Debug("User deleted",
+		slog.String("operation", op),
+		slog.String("user_id", id))
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/repo"
+)
+
+type CatalogService struct {
+	repo repo.Services
+	subs repo.Subscriptions
+}
+
+func NewCatalogService(repo repo.Services, subs repo.Subscriptions) *CatalogService {
+	return &CatalogService{repo: repo, subs: subs}
+}
+
+func (s *CatalogService) CreateService(ctx context.Context, name string, displayName, category *string, defaultPrice *int, currency string, vendorURL *string) (*models.Service, error) {
+	const op = "service.catalog.CreateService"
+
+	if currency == "" {
+		currency = models.BaseCurrency
+	}
+	if err := validateService(&name, defaultPrice, &currency, vendorURL); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	svc, err := s.repo.CreateService(ctx, name, displayName, category, defaultPrice, currency, vendorURL)
+	if err != nil {
+		slog.Error("Failed to create service",
+			slog.String("operation", op),
+			slog.String("service_name", name),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to create service: %w", op, err)
+	}
+
+	slog.Info("Service created",
+		slog.String("operation", op),
+		slog.String("service_name", svc.Name))
+
+	return svc, nil
+}
+
+func (s *CatalogService) Service(ctx context.Context, name string) (*models.Service, error) {
+	const op = "service.catalog.Service"
+
+	if name == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "service_name", Message: "service name cannot be empty"})
+	}
+
+	svc, err := s.repo.Service(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get service: %w", op, err)
+	}
+
+	return svc, nil
+}
+
+func (s *CatalogService) GetAllServices(ctx context.Context, limit, offset int) ([]*models.Service, int, error) {
+	const op = "service.catalog.GetAllServices"
+
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "limit", Message: "limit cannot be negative"})
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "offset", Message: "offset cannot be negative"})
+	}
+
+	services, total, err := s.repo.GetAllServices(ctx, limit, offset)
+	if err != nil {
+		slog.Error("Failed to get all services",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to get services: %w", op, err)
+	}
+
+	return services, total, nil
+}
+
+func (s *CatalogService) UpdateService(ctx context.Context, name string, newName, displayName, category *string, defaultPrice *int, currency, vendorURL *string) (*models.Service, error) {
+	const op = "service.catalog.UpdateService"
+
+	if name == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "service_name", Message: "service name cannot be empty"})
+	}
+	if newName == nil && displayName == nil && category == nil && defaultPrice == nil && currency == nil && vendorURL == nil {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Message: "at least one field must be provided for update"})
+	}
+	if err := validateService(newName, defaultPrice, currency, vendorURL); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// A rename moves the subscriptions in the same transaction, so none is left behind
+	var svc *models.Service
+	moved := 0
+	err := s.subs.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		svc, err = s.repo.UpdateService(ctx, name, newName, displayName, category, defaultPrice, currency, vendorURL)
+		if err != nil {
+			return err
+		}
+		if newName != nil && *newName != name {
+			moved, err = s.subs.RenameService(ctx, name, *newName)
+		}
+		return err
+	})
+	if err != nil {
+		slog.Error("Failed to update service",
+			slog.String("operation", op),
+			slog.String("service_name", name),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to update service: %w", op, err)
+	}
+
+	slog.Info("Service updated",
+		slog.String("operation", op),
+		slog.String("service_name", name),
+		slog.String("new_service_name", svc.Name),
+		slog.Int("moved_subscriptions", moved))
+
+	return svc, nil
+}
+
+func (s *CatalogService) DeleteService(ctx context.Context, name string) error {
+	const op = "service.catalog.DeleteService"
+
+	if name == "" {
+		return fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "service_name", Message: "service name cannot be empty"})
+	}
+
+	if err := s.repo.DeleteService(ctx, name); err != nil {
+		return fmt.Errorf("%s: failed to delete service: %w", op, err)
+	}
+
+	slog.Info("Service deleted",
+		slog.String("operation", op),
+		slog.String("service_name", name))
+
+	return nil
+}
+
+// validateService checks the fields that were provided, nil ones are left alone.
+func validateService(name *string, defaultPrice *int, currency, vendorURL *string) error {
+	if name != nil && *name == "" {
+		return &models.ValidationError{Field: "service_name", Message: "service name cannot be empty"}
+	}
+	if defaultPrice != nil && *defaultPrice < 0 {
+		return &models.ValidationError{Field: "default_price", Message: "default price cannot be negative"}
+	}
+	if currency != nil && !models.IsCurrencyCode(*currency) {
+		return &models.ValidationError{Field: "currency", Message: "currency must be an ISO 4217 code"}
+	}
+	if vendorURL != nil && *vendorURL != "" {
+		u, err := url.ParseRequestURI(*vendorURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &models.ValidationError{Field: "vendor_url", Message: "vendor URL must be an absolute http(s) URL"}
+		}
+	}
+	return nil
+}
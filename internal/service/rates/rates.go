@@ -0,0 +1,104 @@
+package rates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/repo"
+)
+
+type RateService struct {
+	repo repo.Rates
+}
+
+func NewRateService(repo repo.Rates) *RateService {
+	return &RateService{repo: repo}
+}
+
+func (s *RateService) SetRate(ctx context.Context, currency, month string, rate float64) (*models.ExchangeRate, error) {
+	const op = "service.rates.SetRate"
+
+	if err := validateCurrency(currency); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if rate <= 0 {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "rate", Message: "rate must be positive"})
+	}
+	effectiveFrom, err := parseMonth(month)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	r, err := s.repo.SetRate(ctx, currency, effectiveFrom, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to set exchange rate: %w", op, err)
+	}
+
+	slog.Info("Exchange rate set",
+		slog.String("operation", op),
+		slog.String("currency", currency),
+		slog.String("month", month),
+		slog.Float64("rate", rate))
+
+	return r, nil
+}
+
+func (s *RateService) GetRates(ctx context.Context, currency *string) ([]*models.ExchangeRate, error) {
+	const op = "service.rates.GetRates"
+
+	if currency != nil && !models.IsCurrencyCode(*currency) {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "currency", Message: "currency must be an ISO 4217 code"})
+	}
+
+	rates, err := s.repo.GetRates(ctx, currency)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get exchange rates: %w", op, err)
+	}
+
+	return rates, nil
+}
+
+func (s *RateService) DeleteRate(ctx context.Context, currency, month string) error {
+	const op = "service.rates.DeleteRate"
+
+	if err := validateCurrency(currency); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	effectiveFrom, err := parseMonth(month)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.repo.DeleteRate(ctx, currency, effectiveFrom); err != nil {
+		return fmt.Errorf("%s: failed to delete exchange rate: %w", op, err)
+	}
+
+	slog.Info("Exchange rate deleted",
+		slog.String("operation", op),
+		slog.String("currency", currency),
+		slog.String("month", month))
+
+	return nil
+}
+
+// validateCurrency accepts ISO 4217 codes other than the base currency, which is always 1.
+func validateCurrency(currency string) error {
+	if !models.IsCurrencyCode(currency) {
+		return &models.ValidationError{Field: "currency", Message: "currency must be an ISO 4217 code"}
+	}
+	if currency == models.BaseCurrency {
+		return &models.ValidationError{Field: "currency", Message: fmt.Sprintf("%s is the base currency, its rate is always 1", currency)}
+	}
+	return nil
+}
+
+// parseMonth reads the month a rate starts in, a legacy month-year standing for its first day.
+func parseMonth(month string) (models.Date, error) {
+	d, err := models.ParseDate(month)
+	if err != nil {
+		return models.Date{}, &models.ValidationError{Field: "month", Message: "invalid month format, expected MM-YYYY"}
+	}
+	return d, nil
+}
@@ -1,27 +1,71 @@
 package service
 
 import (
+	"context"
+	"io"
+
 	"github.com/DenHax/subscription-manager/internal/domain/models"
 	"github.com/DenHax/subscription-manager/internal/repo"
+	"github.com/DenHax/subscription-manager/internal/service/catalog"
+	"github.com/DenHax/subscription-manager/internal/service/rates"
 	"github.com/DenHax/subscription-manager/internal/service/subscription"
+	"github.com/DenHax/subscription-manager/internal/service/user"
 )
 
 type Subscriptions interface {
-	CreateSubscrition(serviceName string, price int, userID string, startDate string, endDate *string) (*models.Subscription, error)
-	Subscription(id string) (*models.Subscription, error)
-	DeleteSubscription(id string) error
-	GetAllSubscriptions(userID *string, serviceName *string, limit, offset int) ([]*models.Subscription, int, error)
-	UpdateSubscription(id, serviceName *string, price *int, userID *string, startDate *string, endDate *string) (*models.Subscription, error)
-	SummarySubscription(startDate, endDate string, userID *string, serviceName *string) (int, error)
+	CreateSubscrition(ctx context.Context, serviceName string, price int, currency, billingPeriod string, billingInterval int, userID string, startDate string, endDate *string) (*models.Subscription, error)
+	Subscription(ctx context.Context, id string) (*models.Subscription, error)
+	DeleteSubscription(ctx context.Context, id string, versions []int) error
+	RestoreSubscription(ctx context.Context, id string) (*models.Subscription, error)
+	PurgeSubscriptions(ctx context.Context) (int, error)
+	SubscriptionHistory(ctx context.Context, id string, limit, offset int) ([]*models.SubscriptionEvent, int, error)
+	SubscriptionPrices(ctx context.Context, id string) ([]*models.PricePeriod, error)
+	GetAllSubscriptions(ctx context.Context, filter models.SubscriptionFilter, page models.PageRequest) ([]*models.Subscription, models.PageInfo, error)
+	UpdateSubscription(ctx context.Context, id, serviceName *string, price *int, currency *string, priceFrom *string, billingPeriod *string, billingInterval *int, userID *string, startDate *string, endDate *string, versions []int) (*models.Subscription, error)
+	SummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) (*models.Summary, error)
+	MonthlySummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.MonthlyCost, int, error)
+	GroupedSummarySubscription(ctx context.Context, startDate, endDate string, groupBy, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.GroupCost, int, error)
+	BatchSubscriptions(ctx context.Context, ops []models.BatchOperation, atomic bool) ([]models.BatchResult, error)
+	ImportSubscriptions(ctx context.Context, r io.Reader, opts models.ImportOptions, emit func(models.ImportRow) error) (*models.ImportReport, error)
+}
+
+type Services interface {
+	CreateService(ctx context.Context, name string, displayName, category *string, defaultPrice *int, currency string, vendorURL *string) (*models.Service, error)
+	Service(ctx context.Context, name string) (*models.Service, error)
+	GetAllServices(ctx context.Context, limit, offset int) ([]*models.Service, int, error)
+	UpdateService(ctx context.Context, name string, newName, displayName, category *string, defaultPrice *int, currency, vendorURL *string) (*models.Service, error)
+	DeleteService(ctx context.Context, name string) error
+}
+
+type Users interface {
+	CreateUser(ctx context.Context, userID *string, username string) (*models.User, error)
+	User(ctx context.Context, id string) (*models.User, error)
+	GetAllUsers(ctx context.Context, limit, offset int) ([]*models.User, int, error)
+	DeleteUser(ctx context.Context, id string, cascade bool) error
+}
+
+type Rates interface {
+	SetRate(ctx context.Context, currency, month string, rate float64) (*models.ExchangeRate, error)
+	GetRates(ctx context.Context, currency *string) ([]*models.ExchangeRate, error)
+	DeleteRate(ctx context.Context, currency, month string) error
 }
 
 type Service struct {
 	Subscriptions
+	Services
+	Users
+	Rates
 }
 
 func NewService(repos *repo.Repository) *Service {
 	subService := subscription.NewSubService(repos.Subscriptions)
+	catalogService := catalog.NewCatalogService(repos.Services, repos.Subscriptions)
+	userService := user.NewUserService(repos.Users, repos.Subscriptions)
+	rateService := rates.NewRateService(repos.Rates)
 	return &Service{
 		Subscriptions: subService,
+		Services:      catalogService,
+		Users:         userService,
+		Rates:         rateService,
 	}
 }
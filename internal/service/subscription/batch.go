@@ -0,0 +1,139 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+)
+
+// BatchSubscriptions applies ops in order and returns one result per operation. Atomic batches
+// share a transaction, so the first failure rolls back the operations before it and skips the
+// rest, which all report an AbortedError. Otherwise every operation stands on its own.
+func (s *SubService) BatchSubscriptions(ctx context.Context, ops []models.BatchOperation, atomic bool) ([]models.BatchResult, error) {
+	const op = "service.subscription.BatchSubscriptions"
+
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "operations", Message: "batch must contain at least one operation"})
+	}
+	if len(ops) > models.MaxBatchSize {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "operations", Message: fmt.Sprintf("batch cannot contain more than %d operations", models.MaxBatchSize)})
+	}
+
+	results := make([]models.BatchResult, len(ops))
+
+	if !atomic {
+		failed := 0
+		for i, batchOp := range ops {
+			results[i].Subscription, results[i].Err = s.applyBatchOperation(ctx, batchOp)
+			if results[i].Err != nil {
+				failed++
+			}
+		}
+
+		slog.Info("Subscription batch applied",
+			slog.String("operation", op),
+			slog.Int("operations", len(ops)),
+			slog.Int("failed", failed))
+
+		return results, nil
+	}
+
+	failed := -1
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		for i, batchOp := range ops {
+			results[i].Subscription, results[i].Err = s.applyBatchOperation(ctx, batchOp)
+			if results[i].Err != nil {
+				failed = i
+				return results[i].Err
+			}
+		}
+		return nil
+	})
+	if failed >= 0 {
+		for i := range results {
+			if i != failed {
+				results[i] = models.BatchResult{Err: &models.AbortedError{Index: failed}}
+			}
+		}
+
+		slog.Warn("Subscription batch rolled back",
+			slog.String("operation", op),
+			slog.Int("operations", len(ops)),
+			slog.Int("failed_index", failed),
+			slog.Any("error", results[failed].Err))
+
+		return results, nil
+	}
+	if err != nil {
+		slog.Error("Failed to commit subscription batch",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to commit batch: %w", op, err)
+	}
+
+	slog.Info("Subscription batch committed",
+		slog.String("operation", op),
+		slog.Int("operations", len(ops)))
+
+	return results, nil
+}
+
+// applyBatchOperation runs a single batch operation through the same validation as the
+// individual endpoints.
+func (s *SubService) applyBatchOperation(ctx context.Context, batchOp models.BatchOperation) (*models.Subscription, error) {
+	change := batchOp.Change
+
+	// Like the If-Match header of the single endpoints, a version is required to change an
+	// existing subscription
+	if (batchOp.Op == models.BatchUpdate || batchOp.Op == models.BatchDelete) && batchOp.Versions == nil && !batchOp.AnyVersion {
+		return nil, &models.PreconditionRequiredError{Entity: "subscription", ID: batchOp.ID, Field: "if_match"}
+	}
+
+	switch batchOp.Op {
+	case models.BatchCreate:
+		return s.createFromChange(ctx, change)
+	case models.BatchUpdate:
+		return s.UpdateSubscription(ctx, &batchOp.ID, change.ServiceName, change.Price, change.Currency, change.PriceFrom, change.BillingPeriod, change.BillingInterval, change.UserID, change.StartDate, change.EndDate, batchOp.Versions)
+	case models.BatchDelete:
+		return nil, s.DeleteSubscription(ctx, batchOp.ID, batchOp.Versions)
+	default:
+		return nil, &models.ValidationError{Field: "op", Message: "op must be one of create, update, delete"}
+	}
+}
+
+// createFromChange creates a subscription from the fields of a batch operation or import row,
+// which must include those CreateSubscrition requires.
+func (s *SubService) createFromChange(ctx context.Context, change models.SubscriptionChange) (*models.Subscription, error) {
+	required := []struct {
+		field string
+		set   bool
+	}{
+		{"service_name", change.ServiceName != nil},
+		{"price", change.Price != nil},
+		{"user_id", change.UserID != nil},
+		{"start_date", change.StartDate != nil},
+	}
+	for _, r := range required {
+		if !r.set {
+			return nil, &models.ValidationError{Field: r.field, Message: r.field + " is required to create a subscription"}
+		}
+	}
+	if change.PriceFrom != nil {
+		return nil, &models.ValidationError{Field: "price_effective_from", Message: "price effective date only applies to updates"}
+	}
+
+	currency, billingPeriod, billingInterval := "", "", 0
+	if change.Currency != nil {
+		currency = *change.Currency
+	}
+	if change.BillingPeriod != nil {
+		billingPeriod = *change.BillingPeriod
+	}
+	if change.BillingInterval != nil {
+		billingInterval = *change.BillingInterval
+	}
+
+	return s.CreateSubscrition(ctx, *change.ServiceName, *change.Price, currency, billingPeriod, billingInterval, *change.UserID, *change.StartDate, change.EndDate)
+}
@@ -0,0 +1,206 @@
+package subscription
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+)
+
+// errDryRun rolls back a dry-run row once it has been created.
+var errDryRun = errors.New("dry run")
+
+// ImportSubscriptions creates a subscription from every row of a CSV file read from r, one row
+// at a time so large files are never held in memory. The first row is the header. Each row runs
+// through the same validation as CreateSubscrition and stands on its own, its outcome is passed
+// to emit as soon as it is known, rejected or not. An error from emit stops the import.
+func (s *SubService) ImportSubscriptions(ctx context.Context, r io.Reader, opts models.ImportOptions, emit func(models.ImportRow) error) (*models.ImportReport, error) {
+	const op = "service.subscription.ImportSubscriptions"
+
+	reader := csv.NewReader(r)
+	if opts.Delimiter != 0 {
+		if opts.Delimiter == '"' || opts.Delimiter == '\r' || opts.Delimiter == '\n' || !utf8.ValidRune(opts.Delimiter) || opts.Delimiter == utf8.RuneError {
+			return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "delimiter", Message: "delimiter cannot be a quote or line break"})
+		}
+		reader.Comma = opts.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "body", Message: "CSV file is empty"})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "body", Message: fmt.Sprintf("invalid CSV header: %v", err)})
+	}
+
+	columns, err := importColumns(header, opts.Columns)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	report := &models.ImportReport{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var (
+			parseErr *csv.ParseError
+			row      models.ImportRow
+		)
+		switch {
+		case errors.As(err, &parseErr):
+			row.Line = parseErr.StartLine
+			row.Err = &models.ValidationError{Field: "body", Message: parseErr.Err.Error()}
+		case err != nil:
+			return nil, fmt.Errorf("%s: failed to read CSV: %w", op, err)
+		default:
+			row.Line, _ = reader.FieldPos(0)
+			row.Subscription, row.Err = s.importRow(ctx, importChange(record, columns), opts)
+		}
+
+		// Once the import itself is cancelled every further row would fail the same way
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("%s: import stopped at line %d: %w", op, row.Line, err)
+		}
+
+		if row.Err == nil {
+			report.Accepted++
+		} else {
+			report.Rejected++
+		}
+		if err := emit(row); err != nil {
+			return nil, fmt.Errorf("%s: failed to report line %d: %w", op, row.Line, err)
+		}
+	}
+
+	slog.Info("Subscriptions imported",
+		slog.String("operation", op),
+		slog.Bool("dry_run", opts.DryRun),
+		slog.Int("accepted", report.Accepted),
+		slog.Int("rejected", report.Rejected))
+
+	return report, nil
+}
+
+// importColumns finds the position of every field in the header, fields without a column are
+// left out. Headers match case-insensitively.
+func importColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	for field := range mapping {
+		if !slices.Contains(models.ImportFields, field) {
+			return nil, &models.ValidationError{Field: "column", Message: fmt.Sprintf("unknown field %q in column mapping", field)}
+		}
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		// Spreadsheets tend to save a byte order mark in front of the first header
+		name = strings.TrimPrefix(name, "\ufeff")
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	columns := make(map[string]int)
+	for _, field := range models.ImportFields {
+		name, mapped := mapping[field]
+		if !mapped {
+			name = field
+		}
+		i, ok := positions[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			if mapped {
+				return nil, &models.ValidationError{Field: "column", Message: fmt.Sprintf("column %q mapped to %s is missing from the header", name, field)}
+			}
+			continue
+		}
+		columns[field] = i
+	}
+
+	for _, field := range []string{"service_name", "price", "user_id", "start_date"} {
+		if _, ok := columns[field]; !ok {
+			return nil, &models.ValidationError{Field: "column", Message: fmt.Sprintf("no column for required field %s", field)}
+		}
+	}
+
+	return columns, nil
+}
+
+// importChange reads the fields of a record, empty cells are left unset.
+func importChange(record []string, columns map[string]int) map[string]string {
+	values := make(map[string]string, len(columns))
+	for field, i := range columns {
+		if i < len(record) {
+			if value := strings.TrimSpace(record[i]); value != "" {
+				values[field] = value
+			}
+		}
+	}
+	return values
+}
+
+// importRow creates the subscription of one row within the row timeout. A dry run creates it in
+// a transaction that is rolled back, so the row is checked against the database as well.
+func (s *SubService) importRow(ctx context.Context, values map[string]string, opts models.ImportOptions) (*models.Subscription, error) {
+	var change models.SubscriptionChange
+	strs := map[string]**string{
+		"service_name":   &change.ServiceName,
+		"currency":       &change.Currency,
+		"billing_period": &change.BillingPeriod,
+		"user_id":        &change.UserID,
+		"start_date":     &change.StartDate,
+		"end_date":       &change.EndDate,
+	}
+	ints := map[string]**int{
+		"price":            &change.Price,
+		"billing_interval": &change.BillingInterval,
+	}
+	for _, field := range models.ImportFields {
+		value, ok := values[field]
+		if !ok {
+			continue
+		}
+		if dst, ok := strs[field]; ok {
+			*dst = &value
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, &models.ValidationError{Field: field, Message: fmt.Sprintf("%s must be an integer", field)}
+		}
+		*ints[field] = &n
+	}
+
+	if opts.RowTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.RowTimeout)
+		defer cancel()
+	}
+
+	if !opts.DryRun {
+		return s.createFromChange(ctx, change)
+	}
+
+	var sub *models.Subscription
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if sub, err = s.createFromChange(ctx, change); err != nil {
+			return err
+		}
+		return errDryRun
+	})
+	if !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	return sub, nil
+}
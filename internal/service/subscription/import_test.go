@@ -0,0 +1,205 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/repo"
+)
+
+func TestImportColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  []string
+		mapping map[string]string
+		want    map[string]int
+		wantErr string
+	}{
+		{
+			name:   "required fields",
+			header: []string{"service_name", "price", "user_id", "start_date"},
+			want:   map[string]int{"service_name": 0, "price": 1, "user_id": 2, "start_date": 3},
+		},
+		{
+			name:   "case, spaces, byte order mark and unknown columns",
+			header: []string{"\ufeffService_Name", " PRICE ", "notes", "user_id", "Start_Date", "end_date"},
+			want:   map[string]int{"service_name": 0, "price": 1, "user_id": 3, "start_date": 4, "end_date": 5},
+		},
+		{
+			name:    "mapped header",
+			header:  []string{"Service", "Monthly cost", "user_id", "start_date"},
+			mapping: map[string]string{"service_name": "service", "price": "Monthly cost"},
+			want:    map[string]int{"service_name": 0, "price": 1, "user_id": 2, "start_date": 3},
+		},
+		{
+			name:    "mapping replaces the default header",
+			header:  []string{"service_name", "price", "cost", "user_id", "start_date"},
+			mapping: map[string]string{"price": "cost"},
+			want:    map[string]int{"service_name": 0, "price": 2, "user_id": 3, "start_date": 4},
+		},
+		{
+			name:    "missing required field",
+			header:  []string{"service_name", "price", "user_id"},
+			wantErr: "no column for required field start_date",
+		},
+		{
+			name:    "mapped header missing",
+			header:  []string{"service_name", "price", "user_id", "start_date"},
+			mapping: map[string]string{"end_date": "until"},
+			wantErr: `column "until" mapped to end_date is missing from the header`,
+		},
+		{
+			name:    "unknown mapped field",
+			header:  []string{"service_name", "price", "user_id", "start_date"},
+			mapping: map[string]string{"cost": "price"},
+			wantErr: `unknown field "cost" in column mapping`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := importColumns(tt.header, tt.mapping)
+			if tt.wantErr != "" {
+				var validation *models.ValidationError
+				if !errors.As(err, &validation) || validation.Field != "column" {
+					t.Fatalf("importColumns error = %v, want a column ValidationError", err)
+				}
+				if validation.Message != tt.wantErr {
+					t.Errorf("message = %q, want %q", validation.Message, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("importColumns error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("importColumns = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportChange(t *testing.T) {
+	columns := map[string]int{"service_name": 0, "price": 1, "end_date": 3}
+
+	got := importChange([]string{" Netflix ", "400", "ignored", ""}, columns)
+	want := map[string]string{"service_name": "Netflix", "price": "400"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("importChange = %v, want %v", got, want)
+	}
+
+	// Short records leave the missing cells unset
+	got = importChange([]string{"Netflix"}, columns)
+	want = map[string]string{"service_name": "Netflix"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("importChange of a short record = %v, want %v", got, want)
+	}
+}
+
+// importRepo creates every subscription it is given and records the deadline of each call.
+type importRepo struct {
+	repo.Subscriptions
+	created   int
+	deadlines []bool
+}
+
+func (r *importRepo) CreateSubscrition(ctx context.Context, serviceName string, price int, currency, billingPeriod string, billingInterval int, userID string, startDate string, endDate *string) (*models.Subscription, error) {
+	_, hasDeadline := ctx.Deadline()
+	r.deadlines = append(r.deadlines, hasDeadline)
+	r.created++
+	return &models.Subscription{Id: fmt.Sprintf("sub-%d", r.created), ServiceName: serviceName, Price: price}, nil
+}
+
+func TestImportSubscriptionsReport(t *testing.T) {
+	const userID = "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+
+	csv := "service_name;price;user_id;start_date\n" +
+		"Netflix;400;" + userID + ";07-2025\n" +
+		"Netflix;ten;" + userID + ";07-2025\n" +
+		"Netflix;500;" + userID + ";07-2025\n" +
+		"Netflix;\"4\"00;" + userID + ";07-2025\n" +
+		"Netflix;600;" + userID + ";07-2025\n"
+
+	type line struct {
+		line    int
+		id      string
+		errText string
+	}
+	want := []line{
+		{line: 2, id: "sub-1"},
+		{line: 3, errText: "price must be an integer"},
+		{line: 4, id: "sub-2"},
+		{line: 5, errText: `extraneous or missing " in quoted-field`},
+		{line: 6, id: "sub-3"},
+	}
+
+	r := &importRepo{}
+	s := NewSubService(r)
+
+	var got []line
+	report, err := s.ImportSubscriptions(context.Background(), strings.NewReader(csv), models.ImportOptions{
+		Delimiter:  ';',
+		RowTimeout: time.Second,
+	}, func(row models.ImportRow) error {
+		l := line{line: row.Line}
+		if row.Err != nil {
+			l.errText = row.Err.Error()
+		} else {
+			l.id = row.Subscription.Id
+		}
+		got = append(got, l)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ImportSubscriptions error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %+v, want %+v", got, want)
+	}
+	if report.Accepted != 3 || report.Rejected != 2 {
+		t.Errorf("accepted %d, rejected %d, want 3 and 2", report.Accepted, report.Rejected)
+	}
+	for i, hasDeadline := range r.deadlines {
+		if !hasDeadline {
+			t.Errorf("row %d was created without the row timeout", i)
+		}
+	}
+}
+
+func TestImportSubscriptionsStopsWhenEmitFails(t *testing.T) {
+	r := &importRepo{}
+	s := NewSubService(r)
+
+	gone := errors.New("client gone")
+	csv := "service_name,price,user_id,start_date\nNetflix,1,u,07-2025\nNetflix,2,u,07-2025\n"
+	_, err := s.ImportSubscriptions(context.Background(), strings.NewReader(csv), models.ImportOptions{}, func(models.ImportRow) error {
+		return gone
+	})
+	if !errors.Is(err, gone) {
+		t.Errorf("ImportSubscriptions error = %v, want the emit error", err)
+	}
+	if r.created != 1 {
+		t.Errorf("created %d subscriptions, want the import to stop after the first", r.created)
+	}
+}
+
+func TestImportSubscriptionsStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := NewSubService(&importRepo{})
+	_, err := s.ImportSubscriptions(ctx, strings.NewReader("service_name,price,user_id,start_date\nNetflix,1,u,07-2025\n"), models.ImportOptions{}, func(models.ImportRow) error {
+		t.Error("a row was reported after the import was cancelled")
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ImportSubscriptions error = %v, want context.Canceled", err)
+	}
+}
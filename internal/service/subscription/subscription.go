@@ -1,8 +1,10 @@
 package subscription
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/DenHax/subscription-manager/internal/domain/models"
 	"github.com/DenHax/subscription-manager/internal/repo"
@@ -16,25 +18,40 @@ func NewSubService(repo repo.Subscriptions) *SubService {
 	return &SubService{repo: repo}
 }
 
-func (s *SubService) CreateSubscrition(serviceName string, price int, userID string, startDate string, endDate *string) (*models.Subscription, error) {
+func (s *SubService) CreateSubscrition(ctx context.Context, serviceName string, price int, currency, billingPeriod string, billingInterval int, userID string, startDate string, endDate *string) (*models.Subscription, error) {
 	const op = "service.subscription.CreateSubscrition"
 
 	// Basic validation
 	if serviceName == "" {
-		return nil, fmt.Errorf("%s: service name cannot be empty", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "service_name", Message: "service name cannot be empty"})
 	}
 	if price < 0 {
-		return nil, fmt.Errorf("%s: price cannot be negative", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "price", Message: "price cannot be negative"})
+	}
+	if currency == "" {
+		currency = models.BaseCurrency
+	}
+	if !models.IsCurrencyCode(currency) {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "currency", Message: "currency must be an ISO 4217 code"})
+	}
+	if billingPeriod == "" {
+		billingPeriod = models.BillingMonthly
+	}
+	if billingInterval == 0 {
+		billingInterval = 1
+	}
+	if err := validateBilling(&billingPeriod, &billingInterval); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	if userID == "" {
-		return nil, fmt.Errorf("%s: user ID cannot be empty", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "user_id", Message: "user ID cannot be empty"})
 	}
 	if startDate == "" {
-		return nil, fmt.Errorf("%s: start date cannot be empty", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "start_date", Message: "start date cannot be empty"})
 	}
 
 	// Create the subscription via repository
-	sub, err := s.repo.CreateSubscrition(serviceName, price, userID, startDate, endDate)
+	sub, err := s.repo.CreateSubscrition(ctx, serviceName, price, currency, billingPeriod, billingInterval, userID, startDate, endDate)
 	if err != nil {
 		slog.Error("Failed to create subscription",
 			slog.String("operation", op),
@@ -46,23 +63,23 @@ func (s *SubService) CreateSubscrition(serviceName string, price int, userID str
 
 	slog.Info("Subscription created",
 		slog.String("operation", op),
-		slog.Int("subscription_id", sub.Id),
+		slog.String("subscription_id", sub.Id),
 		slog.String("user_id", userID),
 		slog.String("service_name", serviceName))
 
 	return sub, nil
 }
 
-func (s *SubService) Subscription(id string) (*models.Subscription, error) {
+func (s *SubService) Subscription(ctx context.Context, id string) (*models.Subscription, error) {
 	const op = "service.subscription.Subscription"
 
 	// Validate input
 	if id == "" {
-		return nil, fmt.Errorf("%s: subscription ID cannot be empty", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "subscription_id", Message: "subscription ID cannot be empty"})
 	}
 
 	// Get subscription via repository
-	sub, err := s.repo.Subscription(id)
+	sub, err := s.repo.Subscription(ctx, id)
 	if err != nil {
 		slog.Error("Failed to get subscription",
 			slog.String("operation", op),
@@ -78,25 +95,27 @@ func (s *SubService) Subscription(id string) (*models.Subscription, error) {
 	return sub, nil
 }
 
-func (s *SubService) DeleteSubscription(id string) error {
+// DeleteSubscription removes a subscription, a non-nil versions slice requires its current
+// version to be one of them.
+func (s *SubService) DeleteSubscription(ctx context.Context, id string, versions []int) error {
 	const op = "service.subscription.DeleteSubscription"
 
 	// Validate input
 	if id == "" {
-		return fmt.Errorf("%s: subscription ID cannot be empty", op)
+		return fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "subscription_id", Message: "subscription ID cannot be empty"})
 	}
 
 	// Check if subscription exists before deleting
-	_, err := s.repo.Subscription(id)
+	_, err := s.repo.Subscription(ctx, id)
 	if err != nil {
 		slog.Warn("Attempt to delete non-existent subscription",
 			slog.String("operation", op),
 			slog.String("subscription_id", id))
-		return fmt.Errorf("%s: subscription not found: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Delete subscription via repository
-	err = s.repo.DeleteSubscription(id)
+	err = s.repo.DeleteSubscription(ctx, id, versions)
 	if err != nil {
 		slog.Error("Failed to delete subscription",
 			slog.String("operation", op),
@@ -112,58 +131,180 @@ func (s *SubService) DeleteSubscription(id string) error {
 	return nil
 }
 
-func (s *SubService) GetAllSubscriptions(userID *string, serviceName *string, limit, offset int) ([]*models.Subscription, int, error) {
-	const op = "service.subscription.GetAllSubscriptions"
+func (s *SubService) RestoreSubscription(ctx context.Context, id string) (*models.Subscription, error) {
+	const op = "service.subscription.RestoreSubscription"
 
-	// Validate limit and offset
+	if id == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "subscription_id", Message: "subscription ID cannot be empty"})
+	}
+
+	sub, err := s.repo.RestoreSubscription(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to restore subscription: %w", op, err)
+	}
+
+	slog.Info("Subscription restored",
+		slog.String("operation", op),
+		slog.String("subscription_id", id))
+
+	return sub, nil
+}
+
+func (s *SubService) PurgeSubscriptions(ctx context.Context) (int, error) {
+	const op = "service.subscription.PurgeSubscriptions"
+
+	purged, err := s.repo.PurgeSubscriptions(ctx)
+	if err != nil {
+		slog.Error("Failed to purge subscriptions",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return 0, fmt.Errorf("%s: failed to purge subscriptions: %w", op, err)
+	}
+
+	slog.Info("Subscriptions purged",
+		slog.String("operation", op),
+		slog.Int("purged", purged))
+
+	return purged, nil
+}
+
+func (s *SubService) SubscriptionHistory(ctx context.Context, id string, limit, offset int) ([]*models.SubscriptionEvent, int, error) {
+	const op = "service.subscription.SubscriptionHistory"
+
+	if id == "" {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "subscription_id", Message: "subscription ID cannot be empty"})
+	}
 	if limit < 0 {
-		return nil, 0, fmt.Errorf("%s: limit cannot be negative", op)
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "limit", Message: "limit cannot be negative"})
 	}
 	if offset < 0 {
-		return nil, 0, fmt.Errorf("%s: offset cannot be negative", op)
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "offset", Message: "offset cannot be negative"})
+	}
+
+	events, total, err := s.repo.SubscriptionHistory(ctx, id, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: failed to get subscription history: %w", op, err)
+	}
+
+	return events, total, nil
+}
+
+func (s *SubService) SubscriptionPrices(ctx context.Context, id string) ([]*models.PricePeriod, error) {
+	const op = "service.subscription.SubscriptionPrices"
+
+	if id == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "subscription_id", Message: "subscription ID cannot be empty"})
+	}
+
+	prices, err := s.repo.SubscriptionPrices(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get subscription prices: %w", op, err)
+	}
+
+	return prices, nil
+}
+
+// GetAllSubscriptions returns a page of the subscriptions matching filter, paged either by
+// offset or by cursor.
+func (s *SubService) GetAllSubscriptions(ctx context.Context, filter models.SubscriptionFilter, page models.PageRequest) ([]*models.Subscription, models.PageInfo, error) {
+	const op = "service.subscription.GetAllSubscriptions"
+
+	// Empty filters match everything rather than an empty value
+	for _, value := range []**string{&filter.ServiceName, &filter.ServiceNamePrefix, &filter.Status, &filter.ActiveAt, &filter.StartDateFrom, &filter.StartDateTo, &filter.EndDateFrom, &filter.EndDateTo} {
+		if *value != nil && **value == "" {
+			*value = nil
+		}
+	}
+	filter.UserIDs = slices.DeleteFunc(filter.UserIDs, func(id string) bool { return id == "" })
+
+	if filter.Status != nil && !models.IsSubscriptionStatus(*filter.Status) {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "status", Message: "status must be one of upcoming, active, expired"})
+	}
+
+	// Validate price range
+	if filter.MinPrice != nil && *filter.MinPrice < 0 {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "min_price", Message: "min price cannot be negative"})
+	}
+	if filter.MaxPrice != nil && *filter.MaxPrice < 0 {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "max_price", Message: "max price cannot be negative"})
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "max_price", Message: "max price cannot be less than min price"})
+	}
+
+	// Validate limit and offset
+	if page.Limit < 0 {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "limit", Message: "limit cannot be negative"})
+	}
+	if page.Offset < 0 {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "offset", Message: "offset cannot be negative"})
+	}
+	if page.Offset > 0 && page.Cursor != "" {
+		return nil, models.PageInfo{}, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "offset", Message: "offset cannot be combined with cursor"})
 	}
 
 	// Fetch subscriptions via repository
-	subscriptions, totalCount, err := s.repo.GetAllSubscriptions(userID, serviceName, limit, offset)
+	subscriptions, info, err := s.repo.GetAllSubscriptions(ctx, filter, page)
 	if err != nil {
 		slog.Error("Failed to get all subscriptions",
 			slog.String("operation", op),
 			slog.Any("error", err))
-		return nil, 0, fmt.Errorf("%s: failed to get subscriptions: %w", op, err)
+		return nil, models.PageInfo{}, fmt.Errorf("%s: failed to get subscriptions: %w", op, err)
 	}
 
 	slog.Debug("Subscriptions retrieved",
 		slog.String("operation", op),
 		slog.Int("count", len(subscriptions)),
-		slog.Int("total_count", totalCount))
+		slog.Bool("has_next", info.NextCursor != ""))
 
-	return subscriptions, totalCount, nil
+	return subscriptions, info, nil
 }
 
-func (s *SubService) UpdateSubscription(id, serviceName *string, price *int, userID *string, startDate *string, endDate *string) (*models.Subscription, error) {
+// UpdateSubscription changes the given fields, a new price or currency applies from priceFrom
+// onwards.
+// A non-nil versions slice requires the current version to be one of them.
+func (s *SubService) UpdateSubscription(ctx context.Context, id, serviceName *string, price *int, currency *string, priceFrom *string, billingPeriod *string, billingInterval *int, userID *string, startDate *string, endDate *string, versions []int) (*models.Subscription, error) {
 	const op = "service.subscription.UpdateSubscription"
 
 	// Validate subscription ID
 	if id == nil || *id == "" {
-		return nil, fmt.Errorf("%s: subscription ID cannot be empty", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "subscription_id", Message: "subscription ID cannot be empty"})
 	}
 
-	// Validate that at least one field should be updated
-	if serviceName == nil && price == nil && userID == nil && startDate == nil && endDate == nil {
-		return nil, fmt.Errorf("%s: at least one field must be provided for update", op)
+	// Nil fields are left untouched, an empty end date clears it
+	if serviceName != nil && *serviceName == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "service_name", Message: "service name cannot be empty"})
+	}
+	if price != nil && *price < 0 {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "price", Message: "price cannot be negative"})
+	}
+	if currency != nil && !models.IsCurrencyCode(*currency) {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "currency", Message: "currency must be an ISO 4217 code"})
+	}
+	if priceFrom != nil && price == nil && currency == nil {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "price_effective_from", Message: "price effective date requires a price or currency"})
+	}
+	if err := validateBilling(billingPeriod, billingInterval); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if userID != nil && *userID == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "user_id", Message: "user ID cannot be empty"})
+	}
+	if startDate != nil && *startDate == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "start_date", Message: "start date cannot be empty"})
 	}
 
 	// Check if subscription exists before updating
-	_, err := s.repo.Subscription(*id)
+	_, err := s.repo.Subscription(ctx, *id)
 	if err != nil {
 		slog.Warn("Attempt to update non-existent subscription",
 			slog.String("operation", op),
 			slog.String("subscription_id", *id))
-		return nil, fmt.Errorf("%s: subscription not found: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Update subscription via repository
-	updatedSub, err := s.repo.UpdateSubscription(id, serviceName, price, userID, startDate, endDate)
+	updatedSub, err := s.repo.UpdateSubscription(ctx, id, serviceName, price, currency, priceFrom, billingPeriod, billingInterval, userID, startDate, endDate, versions)
 	if err != nil {
 		slog.Error("Failed to update subscription",
 			slog.String("operation", op),
@@ -174,38 +315,146 @@ func (s *SubService) UpdateSubscription(id, serviceName *string, price *int, use
 
 	slog.Info("Subscription updated",
 		slog.String("operation", op),
-		slog.Int("subscription_id", updatedSub.Id))
+		slog.String("subscription_id", updatedSub.Id))
 
 	return updatedSub, nil
 }
 
-func (s *SubService) SummarySubscription(startDate, endDate string, userID *string, serviceName *string) (int, error) {
+func (s *SubService) SummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) (*models.Summary, error) {
 	const op = "service.subscription.SummarySubscription"
 
 	// Validate required dates
 	if startDate == "" {
-		return 0, fmt.Errorf("%s: start date cannot be empty", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "start_date", Message: "start date cannot be empty"})
 	}
 	if endDate == "" {
-		return 0, fmt.Errorf("%s: end date cannot be empty", op)
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "end_date", Message: "end date cannot be empty"})
+	}
+	if err := summaryDefaults(&currency, &proration); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Calculate summary via repository
-	total, err := s.repo.SummarySubscription(startDate, endDate, userID, serviceName)
+	summary, err := s.repo.SummarySubscription(ctx, startDate, endDate, currency, proration, userID, serviceName, includeDeleted)
 	if err != nil {
 		slog.Error("Failed to calculate subscription summary",
 			slog.String("operation", op),
 			slog.String("start_date", startDate),
 			slog.String("end_date", endDate),
 			slog.Any("error", err))
-		return 0, fmt.Errorf("%s: failed to calculate summary: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to calculate summary: %w", op, err)
 	}
 
 	slog.Debug("Subscription summary calculated",
 		slog.String("operation", op),
 		slog.String("start_date", startDate),
 		slog.String("end_date", endDate),
-		slog.Int("summary", total))
+		slog.Int("summary", summary.TotalCost),
+		slog.Int("months", summary.Months))
+
+	return summary, nil
+}
+
+func (s *SubService) MonthlySummarySubscription(ctx context.Context, startDate, endDate, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.MonthlyCost, int, error) {
+	const op = "service.subscription.MonthlySummarySubscription"
+
+	// Validate required dates
+	if startDate == "" {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "start_date", Message: "start date cannot be empty"})
+	}
+	if endDate == "" {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "end_date", Message: "end date cannot be empty"})
+	}
+	if err := summaryDefaults(&currency, &proration); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Calculate per-month breakdown via repository
+	months, total, err := s.repo.MonthlySummarySubscription(ctx, startDate, endDate, currency, proration, userID, serviceName, includeDeleted)
+	if err != nil {
+		slog.Error("Failed to calculate monthly subscription summary",
+			slog.String("operation", op),
+			slog.String("start_date", startDate),
+			slog.String("end_date", endDate),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to calculate monthly summary: %w", op, err)
+	}
+
+	slog.Debug("Monthly subscription summary calculated",
+		slog.String("operation", op),
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate),
+		slog.Int("months", len(months)),
+		slog.Int("total_cost", total))
+
+	return months, total, nil
+}
+
+func (s *SubService) GroupedSummarySubscription(ctx context.Context, startDate, endDate string, groupBy, currency, proration string, userID *string, serviceName *string, includeDeleted bool) ([]*models.GroupCost, int, error) {
+	const op = "service.subscription.GroupedSummarySubscription"
 
-	return total, nil
+	// Validate required dates and grouping
+	if startDate == "" {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "start_date", Message: "start date cannot be empty"})
+	}
+	if endDate == "" {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "end_date", Message: "end date cannot be empty"})
+	}
+	if err := summaryDefaults(&currency, &proration); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if groupBy != models.GroupByServiceName && groupBy != models.GroupByUserID {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "group_by", Message: "unsupported group_by value"})
+	}
+
+	// Calculate per-group costs via repository
+	groups, total, err := s.repo.GroupedSummarySubscription(ctx, startDate, endDate, groupBy, currency, proration, userID, serviceName, includeDeleted)
+	if err != nil {
+		slog.Error("Failed to calculate grouped subscription summary",
+			slog.String("operation", op),
+			slog.String("group_by", groupBy),
+			slog.String("start_date", startDate),
+			slog.String("end_date", endDate),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to calculate grouped summary: %w", op, err)
+	}
+
+	slog.Debug("Grouped subscription summary calculated",
+		slog.String("operation", op),
+		slog.String("group_by", groupBy),
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate),
+		slog.Int("groups", len(groups)),
+		slog.Int("total_cost", total))
+
+	return groups, total, nil
+}
+
+// validateBilling checks the billing fields that were provided, nil ones are left alone.
+func validateBilling(period *string, interval *int) error {
+	if period != nil && !models.IsBillingPeriod(*period) {
+		return &models.ValidationError{Field: "billing_period", Message: "billing period must be weekly, monthly, quarterly or yearly"}
+	}
+	if interval != nil && *interval < 1 {
+		return &models.ValidationError{Field: "billing_interval", Message: "billing interval must be at least 1"}
+	}
+	return nil
+}
+
+// summaryDefaults fills in the base currency and no proration when they are not given and
+// validates them.
+func summaryDefaults(currency, proration *string) error {
+	if *currency == "" {
+		*currency = models.BaseCurrency
+	}
+	if !models.IsCurrencyCode(*currency) {
+		return &models.ValidationError{Field: "currency", Message: "currency must be an ISO 4217 code"}
+	}
+	if *proration == "" {
+		*proration = models.ProrationNone
+	}
+	if *proration != models.ProrationNone && *proration != models.ProrationDaily {
+		return &models.ValidationError{Field: "proration", Message: "proration must be none or daily"}
+	}
+	return nil
 }
@@ -0,0 +1,110 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/repo"
+)
+
+type UserService struct {
+	repo repo.Users
+	subs repo.Subscriptions
+}
+
+func NewUserService(repo repo.Users, subs repo.Subscriptions) *UserService {
+	return &UserService{repo: repo, subs: subs}
+}
+
+func (s *UserService) CreateUser(ctx context.Context, userID *string, username string) (*models.User, error) {
+	const op = "service.user.CreateUser"
+
+	if username == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "username", Message: "username cannot be empty"})
+	}
+
+	user, err := s.repo.CreateUser(ctx, userID, username)
+	if err != nil {
+		slog.Error("Failed to create user",
+			slog.String("operation", op),
+			slog.String("username", username),
+			slog.Any("error", err))
+		return nil, fmt.Errorf("%s: failed to create user: %w", op, err)
+	}
+
+	slog.Info("User created",
+		slog.String("operation", op),
+		slog.String("user_id", user.Id))
+
+	return user, nil
+}
+
+func (s *UserService) User(ctx context.Context, id string) (*models.User, error) {
+	const op = "service.user.User"
+
+	if id == "" {
+		return nil, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "user_id", Message: "user ID cannot be empty"})
+	}
+
+	user, err := s.repo.User(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get user: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (s *UserService) GetAllUsers(ctx context.Context, limit, offset int) ([]*models.User, int, error) {
+	const op = "service.user.GetAllUsers"
+
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "limit", Message: "limit cannot be negative"})
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "offset", Message: "offset cannot be negative"})
+	}
+
+	users, total, err := s.repo.GetAllUsers(ctx, limit, offset)
+	if err != nil {
+		slog.Error("Failed to get all users",
+			slog.String("operation", op),
+			slog.Any("error", err))
+		return nil, 0, fmt.Errorf("%s: failed to get users: %w", op, err)
+	}
+
+	return users, total, nil
+}
+
+func (s *UserService) DeleteUser(ctx context.Context, id string, cascade bool) error {
+	const op = "service.user.DeleteUser"
+
+	if id == "" {
+		return fmt.Errorf("%s: %w", op, &models.ValidationError{Field: "user_id", Message: "user ID cannot be empty"})
+	}
+
+	// A cascade purges the subscriptions first, so each removal lands in the audit log, and
+	// deletes the user in the same transaction
+	purged := 0
+	err := s.subs.InTx(ctx, func(ctx context.Context) error {
+		if cascade {
+			var err error
+			if purged, err = s.subs.PurgeUserSubscriptions(ctx, id); err != nil {
+				return err
+			}
+		}
+		return s.repo.DeleteUser(ctx, id)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to delete user: %w", op, err)
+	}
+
+	slog.Info("User deleted",
+		slog.String("operation", op),
+		slog.String("user_id", id),
+		slog.Bool("cascade", cascade),
+		slog.Int("purged_subscriptions", purged))
+
+	return nil
+}
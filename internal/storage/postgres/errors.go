@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/lib/pq"
+)
+
+const (
+	codeForeignKeyViolation = "23503"
+	codeUniqueViolation     = "23505"
+	codeCheckViolation      = "23514"
+	codeNotNullViolation    = "23502"
+	codeInvalidText         = "22P02"
+	codeInvalidDatetime     = "22007"
+	codeDatetimeOverflow    = "22008"
+)
+
+// MapError translates PostgreSQL errors into domain errors so callers can use errors.Is/As
+// instead of inspecting driver details. Errors it does not recognise are returned unchanged.
+func MapError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case codeForeignKeyViolation:
+		entity, field := referencedEntity(pqErr)
+		return &models.ReferenceError{Entity: entity, Field: field, Value: referencedValue(pqErr)}
+	case codeUniqueViolation:
+		if pqErr.Detail != "" {
+			return &models.ConflictError{Message: pqErr.Detail}
+		}
+		return &models.ConflictError{Message: pqErr.Message}
+	case codeCheckViolation, codeNotNullViolation, codeInvalidText, codeInvalidDatetime, codeDatetimeOverflow:
+		return &models.ValidationError{Field: pqErr.Column, Message: pqErr.Message}
+	}
+
+	return err
+}
+
+func referencedEntity(pqErr *pq.Error) (string, string) {
+	switch {
+	case strings.Contains(pqErr.Constraint, "user_id"):
+		return "user", "user_id"
+	case strings.Contains(pqErr.Constraint, "service_name"):
+		return "service", "service_name"
+	default:
+		return pqErr.Table, pqErr.Column
+	}
+}
+
+// keyDetail matches the detail of a foreign key violation: Key (user_id)=(...) is not present in table "users".
+var keyDetail = regexp.MustCompile(`^Key \([^)]+\)=\((.*)\) is not present`)
+
+func referencedValue(pqErr *pq.Error) string {
+	if m := keyDetail.FindStringSubmatch(pqErr.Detail); m != nil {
+		return m[1]
+	}
+	return ""
+}
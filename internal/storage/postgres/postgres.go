@@ -1,7 +1,9 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/jmoiron/sqlx"
@@ -10,10 +12,16 @@ import (
 
 type Config struct {
 	URL string `env:"POSTGRES_URL" env-required:"true"`
+	// StrictReferences rejects subscriptions for unknown users and services instead of creating them
+	StrictReferences bool `env:"STRICT_REFERENCES" env-default:"false"`
+	// DeletedRetention is how long soft-deleted subscriptions are kept before a purge removes them
+	DeletedRetention time.Duration `env:"DELETED_RETENTION" env-default:"720h"`
 }
 
 type Storage struct {
-	DB *sqlx.DB
+	DB               *sqlx.DB
+	StrictReferences bool
+	DeletedRetention time.Duration
 }
 
 func SetupConfig() (*Config, error) {
@@ -40,7 +48,50 @@ func New(c Config) (*Storage, error) {
 	}
 	fmt.Println("Connection!")
 
-	return &Storage{DB: db}, nil
+	return &Storage{DB: db, StrictReferences: c.StrictReferences, DeletedRetention: c.DeletedRetention}, nil
+}
+
+type txKey struct{}
+
+// WithTx runs fn inside a transaction, committing when it returns nil and rolling back otherwise.
+// Within InTx it joins the shared transaction instead, which InTx commits.
+func (s *Storage) WithTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(tx)
+	}
+
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// InTx runs fn with a context under which every WithTx and Conn share one transaction, so
+// several repository calls commit or roll back together.
+func (s *Storage) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.WithTx(ctx, func(tx *sqlx.Tx) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction started by InTx, or the pool outside of one, so reads see the
+// writes made earlier in the same transaction.
+func (s *Storage) Conn(ctx context.Context) sqlx.ExtContext {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return s.DB
 }
 
 func (s *Storage) Close() error {
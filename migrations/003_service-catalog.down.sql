@@ -0,0 +1,11 @@
+ALTER TABLE subscriptions.subscriptions
+    DROP CONSTRAINT IF EXISTS subscriptions_service_name_fkey,
+    ADD CONSTRAINT subscriptions_service_name_fkey FOREIGN KEY (service_name)
+        REFERENCES subscriptions.services(service_name);
+
+ALTER TABLE subscriptions.services
+    DROP COLUMN IF EXISTS vendor_url,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS default_price,
+    DROP COLUMN IF EXISTS category,
+    DROP COLUMN IF EXISTS display_name;
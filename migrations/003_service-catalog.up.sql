@@ -0,0 +1,13 @@
+-- Catalog metadata for services
+ALTER TABLE subscriptions.services
+    ADD COLUMN IF NOT EXISTS display_name VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS category VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS default_price INTEGER CHECK (default_price >= 0),
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    ADD COLUMN IF NOT EXISTS vendor_url TEXT;
+
+-- Renaming a service renames it in every subscription
+ALTER TABLE subscriptions.subscriptions
+    DROP CONSTRAINT IF EXISTS subscriptions_service_name_fkey,
+    ADD CONSTRAINT subscriptions_service_name_fkey FOREIGN KEY (service_name)
+        REFERENCES subscriptions.services(service_name) ON UPDATE CASCADE;
@@ -0,0 +1,16 @@
+-- Original serial IDs are not recoverable, rows are renumbered
+ALTER TABLE subscriptions.subscriptions
+    ADD COLUMN subscription_serial SERIAL;
+
+ALTER TABLE subscriptions.subscriptions
+    DROP CONSTRAINT IF EXISTS subscriptions_pkey,
+    DROP COLUMN subscription_id;
+
+ALTER TABLE subscriptions.subscriptions
+    RENAME COLUMN subscription_serial TO subscription_id;
+
+ALTER SEQUENCE IF EXISTS subscriptions.subscriptions_subscription_serial_seq
+    RENAME TO subscriptions_subscription_id_seq;
+
+ALTER TABLE subscriptions.subscriptions
+    ADD PRIMARY KEY (subscription_id);
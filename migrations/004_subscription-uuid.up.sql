@@ -0,0 +1,13 @@
+-- Subscriptions are addressed by UUID, existing rows get a generated one
+ALTER TABLE subscriptions.subscriptions
+    ADD COLUMN subscription_uuid UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE subscriptions.subscriptions
+    DROP CONSTRAINT IF EXISTS subscriptions_pkey,
+    DROP COLUMN subscription_id;
+
+ALTER TABLE subscriptions.subscriptions
+    RENAME COLUMN subscription_uuid TO subscription_id;
+
+ALTER TABLE subscriptions.subscriptions
+    ADD PRIMARY KEY (subscription_id);
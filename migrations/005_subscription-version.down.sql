@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions.subscriptions
+    DROP COLUMN IF EXISTS version;
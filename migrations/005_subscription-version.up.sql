@@ -0,0 +1,3 @@
+-- Row version for optimistic concurrency, bumped on every update
+ALTER TABLE subscriptions.subscriptions
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
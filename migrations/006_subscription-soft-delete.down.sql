@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS subscriptions.idx_subscriptions_deleted_at;
+
+-- Soft-deleted rows would otherwise come back to life
+DELETE FROM subscriptions.subscriptions WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE subscriptions.subscriptions
+    DROP COLUMN IF EXISTS deleted_at;
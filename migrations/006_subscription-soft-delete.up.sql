@@ -0,0 +1,6 @@
+-- Deleted subscriptions are kept for past summaries until purged
+ALTER TABLE subscriptions.subscriptions
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_subscriptions_deleted_at
+    ON subscriptions.subscriptions(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,14 @@
+-- Audit log of subscription changes, kept after the subscription itself is purged
+CREATE TABLE IF NOT EXISTS subscriptions.subscription_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    subscription_id UUID NOT NULL,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('created', 'updated', 'deleted', 'restored')),
+    actor VARCHAR(255) NOT NULL,
+    request_id VARCHAR(255),
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_events_subscription_id
+    ON subscriptions.subscription_events(subscription_id, event_id);
@@ -0,0 +1,12 @@
+-- Price periods, each price applies from effective_from until the next period starts
+CREATE TABLE IF NOT EXISTS subscriptions.subscription_prices (
+    subscription_id UUID NOT NULL REFERENCES subscriptions.subscriptions(subscription_id) ON DELETE CASCADE,
+    effective_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    price INTEGER NOT NULL CHECK (price >= 0),
+    PRIMARY KEY (subscription_id, effective_from)
+);
+
+-- Existing subscriptions keep their current price for their whole lifetime
+INSERT INTO subscriptions.subscription_prices (subscription_id, effective_from, price)
+SELECT subscription_id, start_date, price FROM subscriptions.subscriptions
+ON CONFLICT DO NOTHING;
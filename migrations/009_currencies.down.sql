@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS subscriptions.exchange_rates;
+
+ALTER TABLE subscriptions.subscription_prices
+    DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE subscriptions.subscriptions
+    DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,14 @@
+-- Prices carry their currency, existing ones were all in rubles
+ALTER TABLE subscriptions.subscriptions
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE subscriptions.subscription_prices
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+-- Value of one unit of a currency in rubles, each rate applies until the next one starts
+CREATE TABLE IF NOT EXISTS subscriptions.exchange_rates (
+    currency CHAR(3) NOT NULL CHECK (currency <> 'RUB'),
+    effective_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    rate NUMERIC(20, 8) NOT NULL CHECK (rate > 0),
+    PRIMARY KEY (currency, effective_from)
+);
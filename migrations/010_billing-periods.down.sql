@@ -0,0 +1,3 @@
+ALTER TABLE subscriptions.subscriptions
+    DROP COLUMN IF EXISTS billing_interval,
+    DROP COLUMN IF EXISTS billing_period;
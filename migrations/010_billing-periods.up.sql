@@ -0,0 +1,7 @@
+-- Price is charged every billing_interval billing periods counted from start_date,
+-- existing subscriptions were all billed monthly
+ALTER TABLE subscriptions.subscriptions
+    ADD COLUMN IF NOT EXISTS billing_period TEXT NOT NULL DEFAULT 'monthly'
+        CHECK (billing_period IN ('weekly', 'monthly', 'quarterly', 'yearly')),
+    ADD COLUMN IF NOT EXISTS billing_interval INTEGER NOT NULL DEFAULT 1
+        CHECK (billing_interval > 0);
@@ -0,0 +1,3 @@
+UPDATE subscriptions.subscriptions
+SET end_date = date_trunc('month', end_date AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'
+WHERE end_date IS NOT NULL;
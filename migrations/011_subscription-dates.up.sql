@@ -0,0 +1,5 @@
+-- Dates are exact days now. End dates used to stand for the whole month they fell in,
+-- so existing ones move to the last day of that month.
+UPDATE subscriptions.subscriptions
+SET end_date = (date_trunc('month', end_date AT TIME ZONE 'UTC') + INTERVAL '1 month' - INTERVAL '1 day') AT TIME ZONE 'UTC'
+WHERE end_date IS NOT NULL;
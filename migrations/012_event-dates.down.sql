@@ -0,0 +1,9 @@
+UPDATE subscriptions.subscription_events SET
+    before = CASE WHEN before IS NOT NULL THEN before || jsonb_build_object(
+        'start_date', to_char((before->>'start_date')::date, 'YYYY-MM-DD"T00:00:00Z"'),
+        'end_date', to_char((before->>'end_date')::date, 'YYYY-MM-DD"T00:00:00Z"')
+    ) END,
+    after = CASE WHEN after IS NOT NULL THEN after || jsonb_build_object(
+        'start_date', to_char((after->>'start_date')::date, 'YYYY-MM-DD"T00:00:00Z"'),
+        'end_date', to_char((after->>'end_date')::date, 'YYYY-MM-DD"T00:00:00Z"')
+    ) END;
@@ -0,0 +1,10 @@
+-- Subscription snapshots serialize start_date and end_date as plain days now
+UPDATE subscriptions.subscription_events SET
+    before = CASE WHEN before IS NOT NULL THEN before || jsonb_build_object(
+        'start_date', ((before->>'start_date')::timestamptz AT TIME ZONE 'UTC')::date::text,
+        'end_date', ((before->>'end_date')::timestamptz AT TIME ZONE 'UTC')::date::text
+    ) END,
+    after = CASE WHEN after IS NOT NULL THEN after || jsonb_build_object(
+        'start_date', ((after->>'start_date')::timestamptz AT TIME ZONE 'UTC')::date::text,
+        'end_date', ((after->>'end_date')::timestamptz AT TIME ZONE 'UTC')::date::text
+    ) END;
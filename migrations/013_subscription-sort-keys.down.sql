@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS subscriptions.idx_subscriptions_service_name_id;
+DROP INDEX IF EXISTS subscriptions.idx_subscriptions_price_id;
+DROP INDEX IF EXISTS subscriptions.idx_subscriptions_start_date_id;
@@ -0,0 +1,7 @@
+-- Keyset pagination orders by the sort column with the ID as tie-breaker
+CREATE INDEX IF NOT EXISTS idx_subscriptions_start_date_id
+    ON subscriptions.subscriptions(start_date, subscription_id);
+CREATE INDEX IF NOT EXISTS idx_subscriptions_price_id
+    ON subscriptions.subscriptions(price, subscription_id);
+CREATE INDEX IF NOT EXISTS idx_subscriptions_service_name_id
+    ON subscriptions.subscriptions(service_name, subscription_id);
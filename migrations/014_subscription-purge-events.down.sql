@@ -0,0 +1,8 @@
+DELETE FROM subscriptions.subscription_events WHERE action = 'purged';
+
+ALTER TABLE subscriptions.subscription_events
+    DROP CONSTRAINT IF EXISTS subscription_events_action_check;
+
+ALTER TABLE subscriptions.subscription_events
+    ADD CONSTRAINT subscription_events_action_check
+        CHECK (action IN ('created', 'updated', 'deleted', 'restored'));
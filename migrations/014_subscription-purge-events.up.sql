@@ -0,0 +1,7 @@
+-- Purges are audited too, with the last state of the subscription as before
+ALTER TABLE subscriptions.subscription_events
+    DROP CONSTRAINT IF EXISTS subscription_events_action_check;
+
+ALTER TABLE subscriptions.subscription_events
+    ADD CONSTRAINT subscription_events_action_check
+        CHECK (action IN ('created', 'updated', 'deleted', 'restored', 'purged'));
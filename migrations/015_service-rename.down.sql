@@ -0,0 +1,4 @@
+ALTER TABLE subscriptions.subscriptions
+    DROP CONSTRAINT IF EXISTS subscriptions_service_name_fkey,
+    ADD CONSTRAINT subscriptions_service_name_fkey FOREIGN KEY (service_name)
+        REFERENCES subscriptions.services(service_name) ON UPDATE CASCADE;
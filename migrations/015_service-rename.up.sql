@@ -0,0 +1,6 @@
+-- Renames move subscriptions explicitly, so their version is bumped, the constraint is only
+-- deferred until the end of the renaming transaction
+ALTER TABLE subscriptions.subscriptions
+    DROP CONSTRAINT IF EXISTS subscriptions_service_name_fkey,
+    ADD CONSTRAINT subscriptions_service_name_fkey FOREIGN KEY (service_name)
+        REFERENCES subscriptions.services(service_name) DEFERRABLE INITIALLY IMMEDIATE;
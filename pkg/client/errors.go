@@ -0,0 +1,76 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	ErrBadRequest           = errors.New("bad request")
+	ErrValidation           = errors.New("validation failed")
+	ErrNotFound             = errors.New("not found")
+	ErrConflict             = errors.New("conflict")
+	ErrUnknownReference     = errors.New("unknown reference")
+	ErrPrecondition         = errors.New("precondition failed")
+	ErrPreconditionRequired = errors.New("if-match required")
+	ErrTimeout              = errors.New("timeout")
+	ErrServer               = errors.New("server error")
+)
+
+// APIError is a non-2xx response decoded from the API's ErrorResponse body.
+type APIError struct {
+	StatusCode int
+	Code       ErrorDetailCode
+	Message    string
+	Field      string
+}
+
+func (e *APIError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("subscription api: %d %s: %s (field %s)", e.StatusCode, e.Code, e.Message, e.Field)
+	}
+	return fmt.Sprintf("subscription api: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Is lets callers match an APIError against the Err* sentinels.
+func (e *APIError) Is(target error) bool {
+	switch e.Code {
+	case ErrorDetailCodeBadRequest:
+		return target == ErrBadRequest
+	case ErrorDetailCodeValidationError:
+		return target == ErrValidation
+	case ErrorDetailCodeNotFound:
+		return target == ErrNotFound
+	case ErrorDetailCodeConflict:
+		return target == ErrConflict
+	case ErrorDetailCodeUnknownReference:
+		return target == ErrUnknownReference
+	case ErrorDetailCodePreconditionFailed:
+		return target == ErrPrecondition
+	case ErrorDetailCodePreconditionRequired:
+		return target == ErrPreconditionRequired
+	case ErrorDetailCodeTimeout:
+		return target == ErrTimeout
+	}
+	return target == ErrServer && e.StatusCode >= http.StatusInternalServerError
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	var errResp ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil || errResp.Error.Code == "" {
+		apiErr.Code = ErrorDetailCodeInternalError
+		apiErr.Message = http.StatusText(resp.StatusCode)
+		return apiErr
+	}
+
+	apiErr.Code = errResp.Error.Code
+	apiErr.Message = errResp.Error.Message
+	if errResp.Error.Field != nil {
+		apiErr.Field = *errResp.Error.Field
+	}
+	return apiErr
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultRetryWait  = 200 * time.Millisecond
+
+	mergePatchContentType = "application/merge-patch+json"
+)
+
+// Config tunes the transport of a Subscriptions client. Zero values fall back to defaults.
+type Config struct {
+	// HTTPClient sends the requests, http.DefaultClient when nil.
+	HTTPClient *http.Client
+	// MaxRetries bounds retries of idempotent requests, negative disables them.
+	MaxRetries int
+	// RetryWait is the delay before the first retry, it doubles on every attempt.
+	RetryWait time.Duration
+}
+
+// Subscriptions is a typed client for the subscription API. Non-2xx responses are returned
+// as *APIError, which matches the Err* sentinels with errors.Is.
+type Subscriptions struct {
+	api ClientWithResponsesInterface
+}
+
+// NewSubscriptions creates a client for the API served at server, e.g. "http://localhost:8080".
+// opts are applied after the retrying transport, so WithHTTPClient replaces it entirely.
+func NewSubscriptions(server string, cfg Config, opts ...ClientOption) (*Subscriptions, error) {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	retryWait := cfg.RetryWait
+	if retryWait <= 0 {
+		retryWait = defaultRetryWait
+	}
+
+	doer := &retryDoer{client: httpClient, maxRetries: max(maxRetries, 0), wait: retryWait}
+	api, err := NewClientWithResponses(server, append([]ClientOption{WithHTTPClient(doer)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Subscriptions{api: api}, nil
+}
+
+// ETag formats a subscription version for the IfMatch parameters of write requests.
+func ETag(version int) *string {
+	tag := `"` + strconv.Itoa(version) + `"`
+	return &tag
+}
+
+// AnyVersion is the IfMatch value for writes that apply to whatever version is current. Writes
+// must send IfMatch, without it they fail with ErrPreconditionRequired.
+func AnyVersion() *string {
+	tag := "*"
+	return &tag
+}
+
+func (s *Subscriptions) Create(ctx context.Context, req CreateSubscriptionRequest) (*Subscription, error) {
+	resp, err := s.api.CreateSubscriptionWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON201, nil
+}
+
+func (s *Subscriptions) Get(ctx context.Context, id openapi_types.UUID) (*Subscription, error) {
+	resp, err := s.api.GetSubscriptionByIDWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// Update sends req as a JSON merge patch, nil fields are left unchanged. Use ClearEndDate
+// to make a subscription open-ended. Set params.IfMatch with ETag to guard against
+// concurrent edits, a stale version fails with ErrPrecondition, or with AnyVersion to skip
+// the check.
+func (s *Subscriptions) Update(ctx context.Context, id openapi_types.UUID, params UpdateSubscriptionParams, req UpdateSubscriptionRequest) (*Subscription, error) {
+	resp, err := s.api.UpdateSubscriptionWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, id, &params, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ClearEndDate removes the end date of a subscription.
+func (s *Subscriptions) ClearEndDate(ctx context.Context, id openapi_types.UUID, params UpdateSubscriptionParams) (*Subscription, error) {
+	resp, err := s.api.UpdateSubscriptionWithBodyWithResponse(ctx, id, &params, mergePatchContentType, strings.NewReader(`{"end_date":null}`))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// Replace overwrites every field of a subscription, a nil EndDate makes it open-ended.
+func (s *Subscriptions) Replace(ctx context.Context, id openapi_types.UUID, params ReplaceSubscriptionParams, req CreateSubscriptionRequest) (*Subscription, error) {
+	resp, err := s.api.ReplaceSubscriptionWithResponse(ctx, id, &params, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+func (s *Subscriptions) Delete(ctx context.Context, id openapi_types.UUID, params DeleteSubscriptionParams) error {
+	resp, err := s.api.DeleteSubscriptionWithResponse(ctx, id, &params)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// Restore brings back a deleted subscription that has not been purged yet.
+func (s *Subscriptions) Restore(ctx context.Context, id openapi_types.UUID) (*Subscription, error) {
+	resp, err := s.api.RestoreSubscriptionWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// History returns a page of the audit log of a subscription, oldest change first.
+func (s *Subscriptions) History(ctx context.Context, id openapi_types.UUID, params GetSubscriptionHistoryParams) (*SubscriptionEventList, error) {
+	resp, err := s.api.GetSubscriptionHistoryWithResponse(ctx, id, &params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// Prices returns the price periods of a subscription, oldest first.
+func (s *Subscriptions) Prices(ctx context.Context, id openapi_types.UUID) (*PricePeriodList, error) {
+	resp, err := s.api.GetSubscriptionPricesWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+func (s *Subscriptions) List(ctx context.Context, params ListSubscriptionsParams) (*SubscriptionList, error) {
+	resp, err := s.api.ListSubscriptionsWithResponse(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+func (s *Subscriptions) Summary(ctx context.Context, params GetSubscriptionSummaryParams) (*Summary, error) {
+	resp, err := s.api.GetSubscriptionSummaryWithResponse(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
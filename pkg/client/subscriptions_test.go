@@ -0,0 +1,459 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DenHax/subscription-manager/internal/domain/models"
+	"github.com/DenHax/subscription-manager/internal/http/handler"
+	"github.com/DenHax/subscription-manager/internal/service"
+	"github.com/DenHax/subscription-manager/pkg/client"
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	subscriptionID = "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+	userID         = "70601fee-2bf1-4721-ae6f-7636e79a0cba"
+)
+
+// fakeSubscriptions stands in for the subscription service behind the real router. Every call
+// fails with err when it is set, and blocks until the request is cancelled when block is set.
+type fakeSubscriptions struct {
+	service.Subscriptions
+
+	mu       sync.Mutex
+	err      error
+	block    bool
+	calls    int
+	versions []int
+	names    []string
+}
+
+func (f *fakeSubscriptions) call(ctx context.Context) error {
+	f.mu.Lock()
+	f.calls++
+	err, block := f.err, f.block
+	f.mu.Unlock()
+
+	if block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return err
+}
+
+func (f *fakeSubscriptions) Subscription(ctx context.Context, id string) (*models.Subscription, error) {
+	if err := f.call(ctx); err != nil {
+		return nil, err
+	}
+	return subscription(id, 3), nil
+}
+
+func (f *fakeSubscriptions) CreateSubscrition(ctx context.Context, serviceName string, price int, currency, billingPeriod string, billingInterval int, userID string, startDate string, endDate *string) (*models.Subscription, error) {
+	if err := f.call(ctx); err != nil {
+		return nil, err
+	}
+	return subscription(subscriptionID, 1), nil
+}
+
+func (f *fakeSubscriptions) UpdateSubscription(ctx context.Context, id, serviceName *string, price *int, currency *string, priceFrom *string, billingPeriod *string, billingInterval *int, userID *string, startDate *string, endDate *string, versions []int) (*models.Subscription, error) {
+	f.mu.Lock()
+	f.versions = versions
+	if serviceName != nil {
+		f.names = append(f.names, *serviceName)
+	}
+	f.mu.Unlock()
+
+	if err := f.call(ctx); err != nil {
+		return nil, err
+	}
+	return subscription(*id, 4), nil
+}
+
+func (f *fakeSubscriptions) DeleteSubscription(ctx context.Context, id string, versions []int) error {
+	f.mu.Lock()
+	f.versions = versions
+	f.mu.Unlock()
+
+	return f.call(ctx)
+}
+
+func subscription(id string, version int) *models.Subscription {
+	start, _ := models.ParseDate("2025-07-20")
+	return &models.Subscription{
+		Id:              id,
+		UserId:          userID,
+		ServiceName:     "Yandex Plus",
+		Price:           400,
+		Currency:        models.BaseCurrency,
+		BillingPeriod:   models.BillingMonthly,
+		BillingInterval: 1,
+		StartDate:       start,
+		Version:         version,
+	}
+}
+
+// newServer serves the real router over the fake service, every request first going through
+// front when it is given.
+func newServer(t *testing.T, fake *fakeSubscriptions, front func(w http.ResponseWriter, r *http.Request) bool) string {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := handler.NewHandler(&service.Service{Subscriptions: fake}, 0, 0).Init()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if front != nil && front(w, r) {
+			return
+		}
+		router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+func newClient(t *testing.T, server string, cfg client.Config) *client.Subscriptions {
+	t.Helper()
+
+	c, err := client.NewSubscriptions(server, cfg)
+	if err != nil {
+		t.Fatalf("NewSubscriptions error: %v", err)
+	}
+	return c
+}
+
+func uuid(t *testing.T, id string) openapi_types.UUID {
+	t.Helper()
+
+	var u openapi_types.UUID
+	if err := u.UnmarshalText([]byte(id)); err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+var sentinels = []error{
+	client.ErrBadRequest,
+	client.ErrValidation,
+	client.ErrNotFound,
+	client.ErrConflict,
+	client.ErrUnknownReference,
+	client.ErrPrecondition,
+	client.ErrPreconditionRequired,
+	client.ErrTimeout,
+	client.ErrServer,
+}
+
+func TestAPIErrors(t *testing.T) {
+	id := uuid(t, subscriptionID)
+
+	tests := []struct {
+		name       string
+		err        error
+		call       func(c *client.Subscriptions) error
+		wantStatus int
+		wantCode   client.ErrorDetailCode
+		wantField  string
+		want       error
+	}{
+		{
+			name:       "not found",
+			err:        &models.NotFoundError{Entity: "subscription", ID: subscriptionID},
+			wantStatus: http.StatusNotFound,
+			wantCode:   client.ErrorDetailCodeNotFound,
+			want:       client.ErrNotFound,
+		},
+		{
+			name:       "validation",
+			err:        &models.ValidationError{Field: "price", Message: "price cannot be negative"},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   client.ErrorDetailCodeValidationError,
+			wantField:  "price",
+			want:       client.ErrValidation,
+		},
+		{
+			name:       "conflict",
+			err:        &models.ConflictError{Message: "subscription already exists"},
+			wantStatus: http.StatusConflict,
+			wantCode:   client.ErrorDetailCodeConflict,
+			want:       client.ErrConflict,
+		},
+		{
+			name:       "unknown reference",
+			err:        &models.ReferenceError{Entity: "user", Field: "user_id", Value: userID},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   client.ErrorDetailCodeUnknownReference,
+			wantField:  "user_id",
+			want:       client.ErrUnknownReference,
+		},
+		{
+			name:       "stale version",
+			err:        &models.PreconditionError{Entity: "subscription", ID: subscriptionID, Version: 4},
+			wantStatus: http.StatusPreconditionFailed,
+			wantCode:   client.ErrorDetailCodePreconditionFailed,
+			want:       client.ErrPrecondition,
+		},
+		{
+			name:       "timeout",
+			err:        fmt.Errorf("query: %w", context.DeadlineExceeded),
+			wantStatus: http.StatusGatewayTimeout,
+			wantCode:   client.ErrorDetailCodeTimeout,
+			want:       client.ErrTimeout,
+		},
+		{
+			name:       "internal",
+			err:        errors.New("connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   client.ErrorDetailCodeInternalError,
+			want:       client.ErrServer,
+		},
+		{
+			name: "rejected by the spec",
+			call: func(c *client.Subscriptions) error {
+				limit := 1000
+				_, err := c.List(context.Background(), client.ListSubscriptionsParams{Limit: &limit})
+				return err
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   client.ErrorDetailCodeBadRequest,
+			want:       client.ErrBadRequest,
+		},
+		{
+			name: "missing If-Match",
+			call: func(c *client.Subscriptions) error {
+				return c.Delete(context.Background(), id, client.DeleteSubscriptionParams{})
+			},
+			wantStatus: http.StatusPreconditionRequired,
+			wantCode:   client.ErrorDetailCodePreconditionRequired,
+			wantField:  "If-Match",
+			want:       client.ErrPreconditionRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeSubscriptions{err: tt.err}
+			c := newClient(t, newServer(t, fake, nil), client.Config{MaxRetries: -1})
+
+			call := tt.call
+			if call == nil {
+				call = func(c *client.Subscriptions) error {
+					_, err := c.Get(context.Background(), id)
+					return err
+				}
+			}
+			err := call(c)
+
+			var apiErr *client.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want an *APIError", err)
+			}
+			if apiErr.StatusCode != tt.wantStatus || apiErr.Code != tt.wantCode || apiErr.Field != tt.wantField {
+				t.Errorf("got %d %s field %q, want %d %s field %q",
+					apiErr.StatusCode, apiErr.Code, apiErr.Field, tt.wantStatus, tt.wantCode, tt.wantField)
+			}
+			for _, sentinel := range sentinels {
+				if got, want := errors.Is(err, sentinel), sentinel == tt.want; got != want {
+					t.Errorf("errors.Is(err, %q) = %v, want %v", sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	fake := &fakeSubscriptions{}
+	c := newClient(t, newServer(t, fake, nil), client.Config{MaxRetries: -1})
+	id := uuid(t, subscriptionID)
+
+	price := 500
+	sub, err := c.Update(context.Background(), id, client.UpdateSubscriptionParams{IfMatch: client.ETag(3)}, client.UpdateSubscriptionRequest{Price: &price})
+	if err != nil {
+		t.Fatalf("Update error: %v", err)
+	}
+	if sub.Version != 4 {
+		t.Errorf("version = %d, want 4", sub.Version)
+	}
+	if len(fake.versions) != 1 || fake.versions[0] != 3 {
+		t.Errorf("update applied to versions %v, want [3]", fake.versions)
+	}
+
+	if err := c.Delete(context.Background(), id, client.DeleteSubscriptionParams{IfMatch: client.AnyVersion()}); err != nil {
+		t.Fatalf("Delete error: %v", err)
+	}
+	if fake.versions != nil {
+		t.Errorf("delete applied to versions %v, want any", fake.versions)
+	}
+
+	calls := fake.calls
+	if err := c.Delete(context.Background(), id, client.DeleteSubscriptionParams{}); !errors.Is(err, client.ErrPreconditionRequired) {
+		t.Fatalf("Delete without If-Match error = %v, want ErrPreconditionRequired", err)
+	}
+	if fake.calls != calls {
+		t.Error("delete without If-Match reached the service")
+	}
+}
+
+func TestRetry(t *testing.T) {
+	id := uuid(t, subscriptionID)
+	replace := client.CreateSubscriptionRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserId:      uuid(t, userID),
+		StartDate:   "2025-07-20",
+	}
+
+	get := func(c *client.Subscriptions) error {
+		_, err := c.Get(context.Background(), id)
+		return err
+	}
+
+	tests := []struct {
+		name         string
+		status       int
+		failures     int32
+		call         func(c *client.Subscriptions) error
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{name: "get after service unavailable", status: http.StatusServiceUnavailable, failures: 2, call: get, wantAttempts: 3},
+		{name: "get after too many requests", status: http.StatusTooManyRequests, failures: 1, call: get, wantAttempts: 2},
+		{name: "get after bad gateway", status: http.StatusBadGateway, failures: 1, call: get, wantAttempts: 2},
+		{name: "get after gateway timeout", status: http.StatusGatewayTimeout, failures: 1, call: get, wantAttempts: 2},
+		{
+			name:     "delete is idempotent",
+			status:   http.StatusServiceUnavailable,
+			failures: 1,
+			call: func(c *client.Subscriptions) error {
+				return c.Delete(context.Background(), id, client.DeleteSubscriptionParams{IfMatch: client.AnyVersion()})
+			},
+			wantAttempts: 2,
+		},
+		{
+			name:     "put resends its body",
+			status:   http.StatusServiceUnavailable,
+			failures: 1,
+			call: func(c *client.Subscriptions) error {
+				_, err := c.Replace(context.Background(), id, client.ReplaceSubscriptionParams{IfMatch: client.ETag(3)}, replace)
+				return err
+			},
+			wantAttempts: 2,
+		},
+		{name: "gives up after max retries", status: http.StatusServiceUnavailable, failures: 10, call: get, wantAttempts: 4, wantErr: true},
+		{name: "internal error is not retried", status: http.StatusInternalServerError, failures: 1, call: get, wantAttempts: 1, wantErr: true},
+		{name: "client error is not retried", status: http.StatusNotFound, failures: 1, call: get, wantAttempts: 1, wantErr: true},
+		{
+			name:     "post is not retried",
+			status:   http.StatusServiceUnavailable,
+			failures: 1,
+			call: func(c *client.Subscriptions) error {
+				_, err := c.Create(context.Background(), replace)
+				return err
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:     "patch is not retried",
+			status:   http.StatusServiceUnavailable,
+			failures: 1,
+			call: func(c *client.Subscriptions) error {
+				price := 500
+				_, err := c.Update(context.Background(), id, client.UpdateSubscriptionParams{IfMatch: client.ETag(3)}, client.UpdateSubscriptionRequest{Price: &price})
+				return err
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			fake := &fakeSubscriptions{}
+			server := newServer(t, fake, func(w http.ResponseWriter, r *http.Request) bool {
+				if attempts.Add(1) > tt.failures {
+					return false
+				}
+				w.WriteHeader(tt.status)
+				return true
+			})
+			c := newClient(t, server, client.Config{MaxRetries: 3, RetryWait: time.Millisecond})
+
+			err := tt.call(c)
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("error = %v, want success", err)
+				}
+				for _, name := range fake.names {
+					if name != replace.ServiceName {
+						t.Errorf("retried body carried service name %q, want %q", name, replace.ServiceName)
+					}
+				}
+				return
+			}
+
+			var apiErr *client.APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Fatalf("error = %v, want an *APIError with status %d", err, tt.status)
+			}
+			if got, want := errors.Is(err, client.ErrServer), tt.status >= http.StatusInternalServerError; got != want {
+				t.Errorf("errors.Is(err, ErrServer) = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	id := uuid(t, subscriptionID)
+
+	t.Run("while the request runs", func(t *testing.T) {
+		fake := &fakeSubscriptions{block: true}
+		c := newClient(t, newServer(t, fake, nil), client.Config{RetryWait: time.Millisecond})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := c.Get(ctx, id)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	t.Run("while waiting to retry", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := newServer(t, &fakeSubscriptions{}, func(w http.ResponseWriter, r *http.Request) bool {
+			attempts.Add(1)
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return true
+		})
+		c := newClient(t, server, client.Config{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		started := time.Now()
+		_, err := c.Get(ctx, id)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("error = %v, want context.Canceled", err)
+		}
+		if elapsed := time.Since(started); elapsed > 5*time.Second {
+			t.Errorf("returned after %s, want right after the cancellation", elapsed)
+		}
+		if got := attempts.Load(); got != 1 {
+			t.Errorf("attempts = %d, want 1", got)
+		}
+	})
+}